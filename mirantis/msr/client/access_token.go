@@ -0,0 +1,144 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ResponseAccessToken struct, the Token secret is only returned on creation
+type ResponseAccessToken struct {
+	HashedToken string    `json:"hashedToken"`
+	TokenLabel  string    `json:"tokenLabel"`
+	Token       string    `json:"token,omitempty"`
+	IsActive    bool      `json:"isActive"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	LastUsed    time.Time `json:"lastUsed"`
+	LastUsedIP  string    `json:"lastUsedIP"`
+}
+
+// CreateAccessToken creates a personal access token for a user, the current user if username is empty
+func (c *Client) CreateAccessToken(ctx context.Context, username string, label string) (ResponseAccessToken, error) {
+	if label == "" {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token failed. %w: empty label", ErrEmptyStruct)
+	}
+	body, err := json.Marshal(map[string]string{"tokenLabel": label})
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token %s failed. %w: %s", label, ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createMsrUrl("api_tokens"), bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token %s failed. %w: %s", label, ErrRequestCreation, err)
+	}
+	if username != "" {
+		q := req.URL.Query()
+		q.Add("username", username)
+		req.URL.RawQuery = q.Encode()
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token %s failed. %w", label, err)
+	}
+
+	token := ResponseAccessToken{}
+	if err := json.Unmarshal(resBody, &token); err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("creating access token %s failed. %w: %s", label, ErrUnmarshaling, err)
+	}
+
+	return token, nil
+}
+
+// ReadAccessTokens retrieves the access tokens of a user, the current user if username is empty
+func (c *Client) ReadAccessTokens(ctx context.Context, username string) ([]ResponseAccessToken, error) {
+	tokens := []ResponseAccessToken{}
+	pageStart := ""
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("api_tokens"), nil)
+		if err != nil {
+			return []ResponseAccessToken{}, fmt.Errorf("reading access tokens of '%s' failed. %w: %s", username, ErrRequestCreation, err)
+		}
+		q := req.URL.Query()
+		if username != "" {
+			q.Add("username", username)
+		}
+		q.Add("pageSize", strconv.Itoa(MSRPAGESIZE))
+		q.Add("pageStart", pageStart)
+		req.URL.RawQuery = q.Encode()
+
+		body, header, err := c.doRequestWithHeader(req)
+		if err != nil {
+			return []ResponseAccessToken{}, fmt.Errorf("reading access tokens of '%s' failed. %w", username, err)
+		}
+
+		page := []ResponseAccessToken{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return []ResponseAccessToken{}, fmt.Errorf("reading access tokens of '%s' failed. %w: %s", username, ErrUnmarshaling, err)
+		}
+		tokens = append(tokens, page...)
+
+		pageStart = header.Get(MSRNEXTPAGEHEADER)
+		if pageStart == "" || len(page) == 0 {
+			break
+		}
+	}
+
+	return tokens, nil
+}
+
+// ReadAccessToken retrieves a single access token by its hashed ID
+func (c *Client) ReadAccessToken(ctx context.Context, hashedToken string) (ResponseAccessToken, error) {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("api_tokens"), hashedToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("reading access token %s failed. %w: %s", hashedToken, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("reading access token %s failed. %w", hashedToken, err)
+	}
+
+	token := ResponseAccessToken{}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("reading access token %s failed. %w: %s", hashedToken, ErrUnmarshaling, err)
+	}
+
+	return token, nil
+}
+
+// ReadUserAccessToken retrieves an access token of a user, the current user if username is empty.
+// A token belonging to another user is reported as not found.
+func (c *Client) ReadUserAccessToken(ctx context.Context, username string, hashedToken string) (ResponseAccessToken, error) {
+	tokens, err := c.ReadAccessTokens(ctx, username)
+	if err != nil {
+		return ResponseAccessToken{}, fmt.Errorf("reading access token %s of '%s' failed. %w", hashedToken, username, err)
+	}
+	for _, t := range tokens {
+		if t.HashedToken == hashedToken {
+			return t, nil
+		}
+	}
+
+	return ResponseAccessToken{}, fmt.Errorf("reading access token %s of '%s' failed. %w", hashedToken, username, ErrNotFound)
+}
+
+// RevokeAccessToken deletes an access token by its hashed ID
+func (c *Client) RevokeAccessToken(ctx context.Context, hashedToken string) error {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("api_tokens"), hashedToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("revoking access token %s failed. %w: %s", hashedToken, ErrRequestCreation, err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("revoking access token %s failed. %w", hashedToken, err)
+	}
+
+	return nil
+}
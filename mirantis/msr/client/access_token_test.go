@@ -0,0 +1,139 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testAccessTokenStruct struct {
+	server           *httptest.Server
+	expectedResponse client.ResponseAccessToken
+	expectedErr      error
+}
+
+func TestCreateAccessTokenSuccess(t *testing.T) {
+	testToken := client.ResponseAccessToken{
+		HashedToken: "fake-hash",
+		TokenLabel:  "ci",
+		Token:       "fake-secret",
+		IsActive:    true,
+	}
+	mToken, err := json.Marshal(testToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testAccessTokenStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("username") != "builder" {
+				t.Errorf("expected username=builder, got %s", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusCreated)
+			if _, err := w.Write(mToken); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: testToken,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreateAccessToken(ctx, "builder", "ci")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadAccessTokenRevoked(t *testing.T) {
+	tc := testAccessTokenStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			if _, err := w.Write([]byte(`{"errors":[{"code":"NO_SUCH_TOKEN","message":"no such token"}]}`)); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.ResponseAccessToken{},
+		expectedErr:      client.ErrNotFound,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadAccessToken(ctx, "fake-hash")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestCreateAccessTokenEmptyLabel(t *testing.T) {
+	tc := testAccessTokenStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("no request should be sent without a label")
+		})),
+		expectedResponse: client.ResponseAccessToken{},
+		expectedErr:      client.ErrEmptyStruct,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreateAccessToken(ctx, "", "")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadUserAccessTokenOfAnotherUser(t *testing.T) {
+	tc := testAccessTokenStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("username") != "builder" {
+				t.Errorf("expected username=builder, got %s", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(`[{"hashedToken":"builder-hash","tokenLabel":"ci","isActive":true}]`)); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.ResponseAccessToken{},
+		expectedErr:      client.ErrNotFound,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadUserAccessToken(ctx, "builder", "other-hash")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
@@ -22,9 +22,9 @@ type CreateAccount struct {
 
 // UpdateAccount struct
 type UpdateAccount struct {
-	FullName string `json:"fullName,omitempty"`
-	IsActive bool   `json:"isActive,omitempty"`
-	IsAdmin  bool   `json:"isAdmin,omitempty"`
+	FullName string `json:"fullName"`
+	IsActive bool   `json:"isActive"`
+	IsAdmin  bool   `json:"isAdmin"`
 }
 
 // ResponseAccount struct
@@ -46,7 +46,8 @@ type ResponseAccount struct {
 type AccountFilter string
 
 const (
-	Users         AccountFilter = "user"
+	All           AccountFilter = "all"
+	Users         AccountFilter = "users"
 	Orgs          AccountFilter = "orgs"
 	Admins        AccountFilter = "admins"
 	NonAdmins     AccountFilter = "non-admins"
@@ -54,18 +55,28 @@ const (
 	InactiveUsers AccountFilter = "inactive-users"
 )
 
-// APIFormOfFilter is a string readable form of the AccountFilters enum
-func (accF AccountFilter) APIFormOfFilter() string {
-	filters := [...]string{"users", "orgs", "admins", "non-admins", "active-users"}
+// AccountFilters lists every filter enzi accepts, in their API form
+var AccountFilters = []string{
+	string(All),
+	string(Users),
+	string(Orgs),
+	string(Admins),
+	string(NonAdmins),
+	string(ActiveUsers),
+	string(InactiveUsers),
+}
 
+// APIFormOfFilter is a string readable form of the AccountFilters enum,
+// unknown filters fall back to all accounts
+func (accF AccountFilter) APIFormOfFilter() string {
 	x := string(accF)
-	for _, v := range filters {
+	for _, v := range AccountFilters {
 		if v == x {
 			return x
 		}
 	}
 
-	return "all"
+	return string(All)
 }
 
 // CreateAccount method - checking the MSR health endpoint
@@ -159,6 +170,29 @@ func (c *Client) UpdateAccount(ctx context.Context, id string, acc UpdateAccount
 	return resAcc, nil
 }
 
+// ChangePassword changes the password of a user in enzi, oldPassword can be
+// left empty when the client user is an admin
+func (c *Client) ChangePassword(ctx context.Context, id string, oldPassword string, newPassword string) error {
+	if newPassword == "" {
+		return fmt.Errorf("changing password of account %s failed. %w: empty password", id, ErrEmptyStruct)
+	}
+	body, err := json.Marshal(map[string]string{"oldPassword": oldPassword, "newPassword": newPassword})
+	if err != nil {
+		return fmt.Errorf("changing password of account %s failed. %w: %s", id, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s/changePassword", c.createEnziUrl("accounts"), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("changing password of account %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("changing password of account %s failed. %w", id, err)
+	}
+	return nil
+}
+
 // ReadAccounts method retrieves all accounts depending on the filter passed from the enzi endpoint
 func (c *Client) ReadAccounts(ctx context.Context, accFilter AccountFilter) ([]ResponseAccount, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createEnziUrl("accounts"), nil)
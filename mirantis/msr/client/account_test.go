@@ -348,6 +348,36 @@ func TestUpdateAccountFailed(t *testing.T) {
 	}
 }
 
+func TestChangePasswordSuccess(t *testing.T) {
+	tc := testAccountStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/enzi/v0/accounts/fakeid/changePassword" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			got := map[string]string{}
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Error(err)
+			}
+			if got["oldPassword"] != "oldpass" || got["newPassword"] != "newpass" {
+				t.Errorf("unexpected body (%+v)", got)
+			}
+			w.WriteHeader(http.StatusOK)
+		})),
+		expectedErr: nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	err = testClient.ChangePassword(ctx, "fakeid", "oldpass", "newpass")
+
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
 func TestReadAccountsSuccess(t *testing.T) {
 	resAccs := []client.ResponseAccount{}
 	resAccs = append(resAccs,
@@ -391,6 +421,17 @@ func TestReadAccountsSuccess(t *testing.T) {
 	}
 }
 
+func TestAccountFilterAPIForm(t *testing.T) {
+	for _, f := range client.AccountFilters {
+		if got := client.AccountFilter(f).APIFormOfFilter(); got != f {
+			t.Errorf("expected (%s), got (%s)", f, got)
+		}
+	}
+	if got := client.AccountFilter("user").APIFormOfFilter(); got != string(client.All) {
+		t.Errorf("expected (%s), got (%s)", client.All, got)
+	}
+}
+
 func TestReadAccountsFailed(t *testing.T) {
 	tc := testAccountStruct{
 		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -16,6 +16,11 @@ const (
 
 	// MsrURL - Default MSR URL
 	DEFAULTMSRURL = "http://localhost:80"
+
+	// MSRPAGESIZE - Page size used when listing MSR API collections
+	MSRPAGESIZE = 100
+	// MSRNEXTPAGEHEADER - Header carrying the start of the next page of a MSR API collection
+	MSRNEXTPAGEHEADER = "X-Next-Page-Start"
 )
 
 // Client MSR client
@@ -23,12 +28,18 @@ type Client struct {
 	MsrURL     string
 	HTTPClient *http.Client
 	Creds      AuthStruct
+	// Version of the MSR instance, the zero value until LoadVersion is called
+	Version Version
+	// session got by Login, shared by the copies of the client so a renewed one is used by all of them
+	session *session
 }
 
-// AuthStruct basicauth struct
+// AuthStruct credentials struct, a Token is sent as bearer auth and takes
+// precedence over the Username and Password basicauth
 type AuthStruct struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	Token    string `json:"-"`
 }
 
 type Errors struct {
@@ -41,6 +52,21 @@ type ResponseError struct {
 	Errors []Errors `json:"errors"`
 }
 
+// APIError is returned when MSR rejects a request, it keeps every error MSR reported
+// and matches ErrResponseError with errors.Is
+type APIError struct {
+	StatusCode int
+	Errors     []Errors
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s: Status code: %d. ErrMsg: %s", ErrResponseError, e.StatusCode, e.Errors[0].Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return ErrResponseError
+}
+
 // NewDefaultClient creates a new MSR SSL safe Client
 func NewDefaultClient(host, username, password string) (Client, error) {
 	if username == "" || password == "" || host == "" {
@@ -64,6 +90,37 @@ func NewUnsafeSSLClient(host, username, password string) (Client, error) {
 	return NewClient(username, password, host, &http.Client{Transport: tr})
 }
 
+// NewDefaultTokenClient creates a new MSR SSL safe Client authenticating with a token
+func NewDefaultTokenClient(host, token string) (Client, error) {
+	if token == "" || host == "" {
+		return Client{}, ErrEmptyClientArgs
+	}
+
+	return NewTokenClient(token, host, &http.Client{})
+}
+
+// NewUnsafeSSLTokenClient creates a new unsafe MSR HTTP Client authenticating with a token
+func NewUnsafeSSLTokenClient(host, token string) (Client, error) {
+	if token == "" || host == "" {
+		return Client{}, ErrEmptyClientArgs
+	}
+
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+
+	return NewTokenClient(token, host, &http.Client{Transport: tr})
+}
+
+// NewTokenClient creates a new MSR API Client authenticating with a token from raw components
+func NewTokenClient(token, MsrURL string, HTTPClient *http.Client) (Client, error) {
+	return Client{
+		HTTPClient: HTTPClient,
+		MsrURL:     MsrURL,
+		Creds:      AuthStruct{Token: token},
+	}, nil
+}
+
 // NewClient creates a new MSR API Client from raw components
 func NewClient(username, password, MsrURL string, HTTPClient *http.Client) (Client, error) {
 	creds := AuthStruct{
@@ -79,10 +136,48 @@ func NewClient(username, password, MsrURL string, HTTPClient *http.Client) (Clie
 
 // doRequest - performing the actual HTTP request
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	req.SetBasicAuth(c.Creds.Username, c.Creds.Password)
+	body, _, err := c.doRequestWithHeader(req)
+	return body, err
+}
+
+// doRequestWithHeader - performing the actual HTTP request and returning the response headers
+// alongside the body, for endpoints that pass metadata such as paging cursors in headers.
+// A session MSR stopped accepting is renewed and the request sent again once.
+func (c *Client) doRequestWithHeader(req *http.Request) ([]byte, http.Header, error) {
+	token := c.Creds.Token
+	fromSession := token == "" && c.session != nil
+	if fromSession {
+		token = c.session.get()
+	}
+	body, header, err := c.send(req, token)
+	// A streamed body can't be sent again
+	if !fromSession || !errors.Is(err, ErrUnauthorizedReq) || (req.Body != nil && req.GetBody == nil) {
+		return body, header, err
+	}
+
+	renewed, loginErr := c.renewSession(req.Context(), token)
+	if loginErr != nil {
+		return nil, nil, fmt.Errorf("%w. Renewing the session failed: %s", err, loginErr)
+	}
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, nil, fmt.Errorf("%w: %s", ErrRequestCreation, err)
+		}
+	}
+	return c.send(retry, renewed)
+}
+
+// send performs a single HTTP request, with bearer auth when there's a token and basicauth otherwise
+func (c *Client) send(req *http.Request, token string) ([]byte, http.Header, error) {
+	if token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	} else {
+		req.SetBasicAuth(c.Creds.Username, c.Creds.Password)
+	}
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer res.Body.Close()
@@ -90,27 +185,28 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	body, err := ioutil.ReadAll(res.Body)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if res.StatusCode >= http.StatusBadRequest {
 		if res.StatusCode == http.StatusUnauthorized {
-			return nil, fmt.Errorf("%w: Status code: %d", ErrUnauthorizedReq, res.StatusCode)
+			return nil, nil, fmt.Errorf("%w: Status code: %d", ErrUnauthorizedReq, res.StatusCode)
+		}
+		if res.StatusCode == http.StatusNotFound {
+			return nil, nil, fmt.Errorf("%w: Status code: %d", ErrNotFound, res.StatusCode)
 		}
 		errStruct := &ResponseError{}
 		if err := json.Unmarshal(body, errStruct); err != nil {
-			return nil, fmt.Errorf("%w: Status code: %d", ErrUnmarshaling, res.StatusCode)
+			return nil, nil, fmt.Errorf("%w: Status code: %d", ErrUnmarshaling, res.StatusCode)
 		}
 
 		if len(errStruct.Errors) <= 0 {
-			return nil, fmt.Errorf("%w: Status code: %d", ErrEmptyResError, res.StatusCode)
+			return nil, nil, fmt.Errorf("%w: Status code: %d", ErrEmptyResError, res.StatusCode)
 		}
 
-		errMsg := errors.New(errStruct.Errors[0].Message)
-
-		return nil, fmt.Errorf("%w: Status code: %d. ErrMsg: %s", ErrResponseError, res.StatusCode, errMsg)
+		return nil, nil, &APIError{StatusCode: res.StatusCode, Errors: errStruct.Errors}
 	}
 
-	return body, err
+	return body, res.Header, err
 }
 
 func (c *Client) createMsrUrl(endpoint string) string {
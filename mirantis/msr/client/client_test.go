@@ -164,6 +164,32 @@ func TestMSRClientUnauthorized(t *testing.T) {
 	}
 }
 
+func TestMSRClientNotFound(t *testing.T) {
+	tc := testClientStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})),
+		expectedResponse: client.HealthResponse{
+			Healthy: false,
+		},
+		expectedErr: client.ErrNotFound,
+	}
+
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Fatalf("Couldn't create client")
+	}
+	ctx := context.Background()
+	healthy, err := testClient.IsHealthy(ctx)
+	if !reflect.DeepEqual(healthy, tc.expectedResponse.Healthy) {
+		t.Errorf("expected (%v),\n got (%v)", client.Client{}, testClient)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
+
 func TestDoRequestWrongErrorStruct(t *testing.T) {
 	tc := testClientStruct{
 		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
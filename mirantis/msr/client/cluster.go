@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// ReplicaHealthy is the health MSR reports for a healthy replica
+const ReplicaHealthy = "OK"
+
+// RethinkServerStatus struct
+type RethinkServerStatus struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// RethinkTableStatus struct
+type RethinkTableStatus struct {
+	ID     string `json:"id"`
+	DB     string `json:"db"`
+	Name   string `json:"name"`
+	Status struct {
+		AllReplicasReady      bool `json:"all_replicas_ready"`
+		ReadyForOutdatedReads bool `json:"ready_for_outdated_reads"`
+		ReadyForReads         bool `json:"ready_for_reads"`
+		ReadyForWrites        bool `json:"ready_for_writes"`
+	} `json:"status"`
+}
+
+// RethinkSystemTables struct, the RethinkDB system tables MSR exposes
+type RethinkSystemTables struct {
+	ServerStatus []RethinkServerStatus `json:"server_status"`
+	TableStatus  []RethinkTableStatus  `json:"table_status"`
+}
+
+// EtcdMember struct
+type EtcdMember struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+}
+
+// EtcdStatus struct
+type EtcdStatus struct {
+	Healthy bool         `json:"healthy"`
+	Members []EtcdMember `json:"members"`
+}
+
+// ClusterStatus struct, the status of every MSR replica as seen by the one answering
+type ClusterStatus struct {
+	ReplicaHealth       map[string]string   `json:"replica_health"`
+	ReplicaTimestamp    map[string]string   `json:"replica_timestamp"`
+	ReplicaReadonly     map[string]bool     `json:"replica_readonly"`
+	GCLockHolder        string              `json:"gc_lock_holder"`
+	RethinkSystemTables RethinkSystemTables `json:"rethink_system_tables"`
+	EtcdStatus          EtcdStatus          `json:"etcd_status"`
+}
+
+// ReplicaIDs returns the IDs of every replica, sorted
+func (s ClusterStatus) ReplicaIDs() []string {
+	ids := make([]string, 0, len(s.ReplicaHealth))
+	for id := range s.ReplicaHealth {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// AllReplicasHealthy reports whether every replica is healthy
+func (s ClusterStatus) AllReplicasHealthy() bool {
+	if len(s.ReplicaHealth) == 0 {
+		return false
+	}
+	for _, h := range s.ReplicaHealth {
+		if h != ReplicaHealthy {
+			return false
+		}
+	}
+	return true
+}
+
+// UnreadyTables returns the 'db.table' names of the RethinkDB tables which
+// don't have all of their replicas ready, sorted
+func (s ClusterStatus) UnreadyTables() []string {
+	tables := []string{}
+	for _, t := range s.RethinkSystemTables.TableStatus {
+		if !t.Status.AllReplicasReady {
+			tables = append(tables, fmt.Sprintf("%s.%s", t.DB, t.Name))
+		}
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// ReadClusterStatus retrieves the status of the MSR cluster
+func (c *Client) ReadClusterStatus(ctx context.Context) (ClusterStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("meta/cluster_status"), nil)
+	if err != nil {
+		return ClusterStatus{}, fmt.Errorf("reading cluster status failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ClusterStatus{}, fmt.Errorf("reading cluster status failed. %w", err)
+	}
+
+	status := ClusterStatus{}
+	if err := json.Unmarshal(body, &status); err != nil {
+		return ClusterStatus{}, fmt.Errorf("reading cluster status failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,87 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+const testClusterStatus = `{
+	"replica_health": {"b2c3d4e5f6a1": "OK", "a1b2c3d4e5f6": "OK"},
+	"replica_readonly": {"a1b2c3d4e5f6": false, "b2c3d4e5f6a1": false},
+	"rethink_system_tables": {
+		"server_status": [{"id": "s1", "name": "dtr_rethinkdb_a1b2c3d4e5f6"}],
+		"table_status": [
+			{"db": "dtr2", "name": "tags", "status": {"all_replicas_ready": true}},
+			{"db": "dtr2", "name": "manifests", "status": {"all_replicas_ready": false}}
+		]
+	},
+	"etcd_status": {"healthy": true}
+}`
+
+func TestReadClusterStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v0/meta/cluster_status" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(testClusterStatus)); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	status, err := testClient.ReadClusterStatus(ctx)
+	if err != nil {
+		t.Fatalf("expected (%v), got (%v)", nil, err)
+	}
+	if expected := []string{"a1b2c3d4e5f6", "b2c3d4e5f6a1"}; !reflect.DeepEqual(expected, status.ReplicaIDs()) {
+		t.Errorf("expected (%v), got (%v)", expected, status.ReplicaIDs())
+	}
+	if !status.AllReplicasHealthy() {
+		t.Error("expected all replicas to be healthy")
+	}
+	if expected := []string{"dtr2.manifests"}; !reflect.DeepEqual(expected, status.UnreadyTables()) {
+		t.Errorf("expected (%v), got (%v)", expected, status.UnreadyTables())
+	}
+	if !status.EtcdStatus.Healthy {
+		t.Error("expected etcd to be healthy")
+	}
+}
+
+func TestClusterStatusUnhealthyReplica(t *testing.T) {
+	status := client.ClusterStatus{ReplicaHealth: map[string]string{
+		"a1b2c3d4e5f6": client.ReplicaHealthy,
+		"b2c3d4e5f6a1": "rethinkdb unreachable",
+	}}
+	if status.AllReplicasHealthy() {
+		t.Error("expected a replica to be unhealthy")
+	}
+	if (client.ClusterStatus{}).AllReplicasHealthy() {
+		t.Error("expected no replicas not to be reported healthy")
+	}
+}
+
+func TestReadClusterStatusUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if _, err := testClient.ReadClusterStatus(ctx); !errors.Is(err, client.ErrUnauthorizedReq) {
+		t.Errorf("expected (%v), got (%v)", client.ErrUnauthorizedReq, err)
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// GCCronAction is the action of the online garbage collection cron
+const GCCronAction = "onlinegc"
+
+// Cron struct, the schedule uses the 6 field cron syntax (with seconds)
+type Cron struct {
+	Schedule    string `json:"schedule"`
+	Retries     int    `json:"retries"`
+	Deadline    string `json:"deadline,omitempty"`
+	StopTimeout string `json:"stopTimeout,omitempty"`
+}
+
+// ResponseCron struct
+type ResponseCron struct {
+	ID          string    `json:"id"`
+	Action      string    `json:"action"`
+	Schedule    string    `json:"schedule"`
+	Retries     int       `json:"retries"`
+	Deadline    string    `json:"deadline"`
+	StopTimeout string    `json:"stopTimeout"`
+	NextRun     time.Time `json:"nextRun"`
+}
+
+// ReadCrons retrieves all the crons
+func (c *Client) ReadCrons(ctx context.Context) ([]ResponseCron, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("crons"), nil)
+	if err != nil {
+		return []ResponseCron{}, fmt.Errorf("reading crons failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return []ResponseCron{}, fmt.Errorf("reading crons failed. %w", err)
+	}
+
+	crons := struct {
+		Crons []ResponseCron `json:"crons"`
+	}{}
+	if err := json.Unmarshal(body, &crons); err != nil {
+		return []ResponseCron{}, fmt.Errorf("reading crons failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return crons.Crons, nil
+}
+
+// ReadCron retrieves the cron of a job action
+func (c *Client) ReadCron(ctx context.Context, action string) (ResponseCron, error) {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("crons"), action)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponseCron{}, fmt.Errorf("reading cron %s failed. %w: %s", action, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponseCron{}, fmt.Errorf("reading cron %s failed. %w", action, err)
+	}
+
+	cron := ResponseCron{}
+	if err := json.Unmarshal(body, &cron); err != nil {
+		return ResponseCron{}, fmt.Errorf("reading cron %s failed. %w: %s", action, ErrUnmarshaling, err)
+	}
+
+	return cron, nil
+}
+
+// UpdateCron creates or replaces the cron of a job action
+func (c *Client) UpdateCron(ctx context.Context, action string, cron Cron) (ResponseCron, error) {
+	if cron.Schedule == "" {
+		return ResponseCron{}, fmt.Errorf("updating cron %s failed. %w: %+v", action, ErrEmptyStruct, cron)
+	}
+	body, err := json.Marshal(cron)
+	if err != nil {
+		return ResponseCron{}, fmt.Errorf("updating cron %s failed. %w: %s", action, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("crons"), action)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseCron{}, fmt.Errorf("updating cron %s failed. %w: %s", action, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseCron{}, fmt.Errorf("updating cron %s failed. %w", action, err)
+	}
+
+	resCron := ResponseCron{}
+	if err := json.Unmarshal(resBody, &resCron); err != nil {
+		return ResponseCron{}, fmt.Errorf("updating cron %s failed. %w: %s", action, ErrUnmarshaling, err)
+	}
+
+	return resCron, nil
+}
+
+// DeleteCron deletes the cron of a job action
+func (c *Client) DeleteCron(ctx context.Context, action string) error {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("crons"), action)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting cron %s failed. %w: %s", action, ErrRequestCreation, err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("deleting cron %s failed. %w", action, err)
+	}
+
+	return nil
+}
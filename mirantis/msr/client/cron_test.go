@@ -0,0 +1,290 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testCronStruct struct {
+	server           *httptest.Server
+	expectedResponse client.ResponseCron
+	expectedErr      error
+}
+
+var testCron = client.ResponseCron{
+	ID:       "fake-cron-id",
+	Action:   client.GCCronAction,
+	Schedule: "0 0 1 * * 6",
+	Retries:  1,
+	Deadline: "4h",
+	NextRun:  time.Date(2026, 10, 24, 1, 0, 0, 0, time.UTC),
+}
+
+// cronAPIErrorServer answers every request with a 400 ResponseError
+func cronAPIErrorServer(t *testing.T) *httptest.Server {
+	resError := client.ResponseError{
+		Errors: []client.Errors{
+			{
+				Code:    strconv.Itoa(http.StatusBadRequest),
+				Message: "invalid cron schedule",
+			},
+		},
+	}
+	bodyRes, err := json.Marshal(resError)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		if _, err := w.Write(bodyRes); err != nil {
+			t.Error(err)
+		}
+	}))
+}
+
+// expectAPIError checks err is the 400 APIError sent by cronAPIErrorServer
+func expectAPIError(t *testing.T, err error) {
+	t.Helper()
+	if !errors.Is(err, client.ErrResponseError) {
+		t.Errorf("expected (%v), got (%v)", client.ErrResponseError, err)
+	}
+	apiErr := &client.APIError{}
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected an APIError with status (%d), got (%v)", http.StatusBadRequest, err)
+	}
+}
+
+func TestReadCronsSuccess(t *testing.T) {
+	mCrons, err := json.Marshal(map[string]interface{}{"crons": []client.ResponseCron{testCron}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v0/crons" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mCrons); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadCrons(ctx)
+	if expected := []client.ResponseCron{testCron}; !reflect.DeepEqual(expected, resp) {
+		t.Errorf("expected (%+v), got (%+v)", expected, resp)
+	}
+	if err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+}
+
+func TestReadCronsAPIError(t *testing.T) {
+	server := cronAPIErrorServer(t)
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadCrons(ctx)
+	if len(resp) != 0 {
+		t.Errorf("expected no crons, got (%+v)", resp)
+	}
+	expectAPIError(t, err)
+}
+
+func TestReadCronSuccess(t *testing.T) {
+	mCron, err := json.Marshal(testCron)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testCronStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Path != "/api/v0/crons/onlinegc" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mCron); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: testCron,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadCron(ctx, client.GCCronAction)
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadCronNotFound(t *testing.T) {
+	tc := testCronStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})),
+		expectedResponse: client.ResponseCron{},
+		expectedErr:      client.ErrNotFound,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadCron(ctx, client.GCCronAction)
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestUpdateCronSuccess(t *testing.T) {
+	mCron, err := json.Marshal(testCron)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron := client.Cron{Schedule: testCron.Schedule, Retries: testCron.Retries, Deadline: testCron.Deadline}
+	tc := testCronStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/api/v0/crons/onlinegc" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			sent := client.Cron{}
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Error(err)
+			}
+			if !reflect.DeepEqual(cron, sent) {
+				t.Errorf("expected (%+v) to be sent, got (%+v)", cron, sent)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mCron); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: testCron,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateCron(ctx, client.GCCronAction, cron)
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestUpdateCronNoSchedule(t *testing.T) {
+	tc := testCronStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("no request should be sent for a cron without schedule")
+		})),
+		expectedResponse: client.ResponseCron{},
+		expectedErr:      client.ErrEmptyStruct,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateCron(ctx, client.GCCronAction, client.Cron{Retries: 1})
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestUpdateCronAPIError(t *testing.T) {
+	server := cronAPIErrorServer(t)
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateCron(ctx, client.GCCronAction, client.Cron{Schedule: "never"})
+	if !reflect.DeepEqual(client.ResponseCron{}, resp) {
+		t.Errorf("expected (%+v), got (%+v)", client.ResponseCron{}, resp)
+	}
+	expectAPIError(t, err)
+}
+
+func TestDeleteCronSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v0/crons/onlinegc" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if err := testClient.DeleteCron(ctx, client.GCCronAction); err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+}
+
+func TestDeleteCronNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if err := testClient.DeleteCron(ctx, client.GCCronAction); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("expected (%v), got (%v)", client.ErrNotFound, err)
+	}
+}
+
+func TestDeleteCronAPIError(t *testing.T) {
+	server := cronAPIErrorServer(t)
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	expectAPIError(t, testClient.DeleteCron(ctx, client.GCCronAction))
+}
@@ -3,14 +3,23 @@ package client
 import "errors"
 
 var (
-	ErrEmptyClientArgs = errors.New("MSR client did not receive host, username and/or password")
+	ErrEmptyClientArgs = errors.New("MSR client did not receive host, username and/or password or token")
 	ErrRequestCreation = errors.New("creating request failed in MSR client")
 	ErrMarshaling      = errors.New("marshalling struct failed in MSR client")
 	ErrUnmarshaling    = errors.New("unmarshalling struc failed in MSR client")
 	ErrEmptyResError   = errors.New("request returned empty ResponseError struct in MSR client")
 	ErrResponseError   = errors.New("request returned ResponseError in MSR client")
 	ErrUnauthorizedReq = errors.New("unauthorized request in MSR client")
+	ErrNotFound        = errors.New("requested resource not found in MSR client")
 	ErrEmptyStruct     = errors.New("empty struct passed in MSR client")
 	ErrInvalidFilter   = errors.New("passing invalid account retrieval filter in MSR client")
 	ErrIDHasNoRepoName = errors.New("ID doesn't contain repository name in MSR client")
+	ErrInvalidVersion  = errors.New("unparsable version in MSR client")
+	ErrUnsupported     = errors.New("feature not supported by the MSR version")
+
+	ErrInvalidStorageConfig  = errors.New("invalid storage configuration in MSR client")
+	ErrJobFailed             = errors.New("job did not complete successfully in MSR client")
+	ErrTeamMembers           = errors.New("updating team members failed in MSR client")
+	ErrInvalidPasswordPolicy = errors.New("invalid password policy in MSR client")
+	ErrWebhookDelivery       = errors.New("webhook test event was not delivered in MSR client")
 )
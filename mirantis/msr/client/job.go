@@ -0,0 +1,200 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Job statuses reported by MSR
+const (
+	JobWaiting  = "waiting"
+	JobRunning  = "running"
+	JobDone     = "done"
+	JobCanceled = "canceled"
+	JobErrored  = "errored"
+)
+
+// DefaultJobPollInterval is the interval WaitForJob polls a job with when none is given
+const DefaultJobPollInterval = 5 * time.Second
+
+// JobCancelTimeout bounds the cancellation of a job WaitForJob stopped waiting for
+const JobCancelTimeout = 30 * time.Second
+
+// Job struct
+type Job struct {
+	ID           string            `json:"id"`
+	RetryFromID  string            `json:"retryFromID"`
+	WorkerID     string            `json:"workerID"`
+	Status       string            `json:"status"`
+	Action       string            `json:"action"`
+	ScheduledAt  time.Time         `json:"scheduledAt"`
+	LastUpdated  time.Time         `json:"lastUpdated"`
+	RetriesLeft  int               `json:"retriesLeft"`
+	RetriesTotal int               `json:"retriesTotal"`
+	Parameters   map[string]string `json:"parameters"`
+	Deadline     string            `json:"deadline"`
+	StopTimeout  string            `json:"stopTimeout"`
+}
+
+// IsFinished reports whether the job reached a final status
+func (j Job) IsFinished() bool {
+	return j.Status == JobDone || j.Status == JobCanceled || j.Status == JobErrored
+}
+
+// JobFilter narrows down the jobs listed by ReadJobs, zero values aren't filtered on
+type JobFilter struct {
+	Action  string
+	Worker  string
+	Running *bool
+	Limit   int
+}
+
+// ReadJobs retrieves the most recent jobs matching the filter
+func (c *Client) ReadJobs(ctx context.Context, filter JobFilter) ([]Job, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("jobs"), nil)
+	if err != nil {
+		return []Job{}, fmt.Errorf("reading jobs failed. %w: %s", ErrRequestCreation, err)
+	}
+	q := req.URL.Query()
+	if filter.Action != "" {
+		q.Add("action", filter.Action)
+	}
+	if filter.Worker != "" {
+		q.Add("worker", filter.Worker)
+	}
+	if filter.Running != nil {
+		q.Add("running", strconv.FormatBool(*filter.Running))
+	}
+	if filter.Limit > 0 {
+		q.Add("limit", strconv.Itoa(filter.Limit))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return []Job{}, fmt.Errorf("reading jobs failed. %w", err)
+	}
+
+	jobs := struct {
+		Jobs []Job `json:"jobs"`
+	}{}
+	if err := json.Unmarshal(body, &jobs); err != nil {
+		return []Job{}, fmt.Errorf("reading jobs failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return jobs.Jobs, nil
+}
+
+// ReadJob retrieves a single job
+func (c *Client) ReadJob(ctx context.Context, id string) (Job, error) {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("jobs"), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Job{}, fmt.Errorf("reading job %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return Job{}, fmt.Errorf("reading job %s failed. %w", id, err)
+	}
+
+	job := Job{}
+	if err := json.Unmarshal(body, &job); err != nil {
+		return Job{}, fmt.Errorf("reading job %s failed. %w: %s", id, ErrUnmarshaling, err)
+	}
+
+	return job, nil
+}
+
+// CreateJob starts a job for the given action right away
+func (c *Client) CreateJob(ctx context.Context, action string) (Job, error) {
+	if action == "" {
+		return Job{}, fmt.Errorf("creating job failed. %w: empty action", ErrEmptyStruct)
+	}
+	body, err := json.Marshal(map[string]string{"action": action})
+	if err != nil {
+		return Job{}, fmt.Errorf("creating %s job failed. %w: %s", action, ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createMsrUrl("jobs"), bytes.NewBuffer(body))
+	if err != nil {
+		return Job{}, fmt.Errorf("creating %s job failed. %w: %s", action, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return Job{}, fmt.Errorf("creating %s job failed. %w", action, err)
+	}
+
+	job := Job{}
+	if err := json.Unmarshal(resBody, &job); err != nil {
+		return Job{}, fmt.Errorf("creating %s job failed. %w: %s", action, ErrUnmarshaling, err)
+	}
+
+	return job, nil
+}
+
+// CancelJob cancels a waiting or running job
+func (c *Client) CancelJob(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/%s/cancel", c.createMsrUrl("jobs"), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return fmt.Errorf("canceling job %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("canceling job %s failed. %w", id, err)
+	}
+
+	return nil
+}
+
+// WaitForJob polls a job until it is finished or the context is done.
+// A job which didn't succeed is returned along with ErrJobFailed, and a job
+// still running when the context is done is canceled so it doesn't outlive the caller.
+func (c *Client) WaitForJob(ctx context.Context, id string, interval time.Duration) (Job, error) {
+	if interval <= 0 {
+		interval = DefaultJobPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job, err := c.ReadJob(ctx, id)
+		if err != nil {
+			err = fmt.Errorf("waiting for job %s failed. %w", id, err)
+			if ctx.Err() != nil {
+				return Job{}, c.abandonJob(id, err)
+			}
+			return Job{}, err
+		}
+		if job.IsFinished() {
+			if job.Status != JobDone {
+				return job, fmt.Errorf("waiting for job %s failed. %w: status %s", id, ErrJobFailed, job.Status)
+			}
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, c.abandonJob(id, fmt.Errorf("waiting for job %s failed, last status %s. %w", id, job.Status, ctx.Err()))
+		case <-ticker.C:
+		}
+	}
+}
+
+// abandonJob cancels a job nobody waits for anymore, the waiting context being
+// done the cancellation gets its own
+func (c *Client) abandonJob(id string, waitErr error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), JobCancelTimeout)
+	defer cancel()
+	if err := c.CancelJob(ctx, id); err != nil {
+		return fmt.Errorf("%w. %s", waitErr, err)
+	}
+	return waitErr
+}
@@ -0,0 +1,119 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+// jobServer reports the job as running for the first polls, then with the final status
+func jobServer(t *testing.T, runningPolls int32, finalStatus string) *httptest.Server {
+	var polls int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		job := client.Job{ID: "fake-job-id", Action: "onlinegc", Status: client.JobRunning}
+		if atomic.AddInt32(&polls, 1) > runningPolls {
+			job.Status = finalStatus
+		}
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(job); err != nil {
+			t.Error(err)
+		}
+	}))
+}
+
+func TestWaitForJobDone(t *testing.T) {
+	server := jobServer(t, 2, client.JobDone)
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	job, err := testClient.WaitForJob(ctx, "fake-job-id", time.Millisecond)
+	if err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+	if job.Status != client.JobDone {
+		t.Errorf("expected (%s), got (%s)", client.JobDone, job.Status)
+	}
+}
+
+func TestWaitForJobErrored(t *testing.T) {
+	server := jobServer(t, 0, client.JobErrored)
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	job, err := testClient.WaitForJob(ctx, "fake-job-id", time.Millisecond)
+	if !errors.Is(err, client.ErrJobFailed) {
+		t.Errorf("expected (%v), got (%v)", client.ErrJobFailed, err)
+	}
+	if job.Status != client.JobErrored {
+		t.Errorf("expected (%s), got (%s)", client.JobErrored, job.Status)
+	}
+}
+
+func TestWaitForJobDeadline(t *testing.T) {
+	var canceled int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v0/jobs/fake-job-id/cancel" {
+			atomic.AddInt32(&canceled, 1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(client.Job{ID: "fake-job-id", Status: client.JobRunning}); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = testClient.WaitForJob(ctx, "fake-job-id", time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected (%v), got (%v)", context.DeadlineExceeded, err)
+	}
+	if atomic.LoadInt32(&canceled) != 1 {
+		t.Errorf("expected the job to be canceled once, got (%d)", canceled)
+	}
+}
+
+func TestReadJobsFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("action") != "onlinegc" || q.Get("running") != "false" || q.Get("limit") != "5" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"jobs":[{"id":"job-1","action":"onlinegc","status":"done"}]}`)); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	running := false
+	jobs, err := testClient.ReadJobs(ctx, client.JobFilter{Action: "onlinegc", Running: &running, Limit: 5})
+	if err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+	if len(jobs) != 1 || jobs[0].ID != "job-1" {
+		t.Errorf("unexpected jobs (%+v)", jobs)
+	}
+}
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Enzi authentication backends
+const (
+	ManagedAuthBackend = "managed"
+	LDAPAuthBackend    = "ldap"
+)
+
+// AuthConfig struct, selects the enzi authentication backend
+type AuthConfig struct {
+	Backend string `json:"backend"`
+}
+
+// LDAPServer struct, connection settings of a LDAP server.
+// Domain is only used by the additional domain servers
+type LDAPServer struct {
+	Domain             string `json:"domain,omitempty"`
+	ServerURL          string `json:"serverURL"`
+	NoSimplePagination bool   `json:"noSimplePagination"`
+	StartTLS           bool   `json:"startTLS"`
+	RootCerts          string `json:"rootCerts"`
+	TLSSkipVerify      bool   `json:"tlsSkipVerify"`
+	ReaderDN           string `json:"readerDN"`
+	ReaderPassword     string `json:"readerPassword,omitempty"`
+}
+
+// LDAPUserSearchConfig struct, which LDAP entries are synced as enzi users
+type LDAPUserSearchConfig struct {
+	BaseDN               string `json:"baseDN"`
+	ScopeSubtree         bool   `json:"scopeSubtree"`
+	UsernameAttr         string `json:"usernameAttr"`
+	FullNameAttr         string `json:"fullNameAttr"`
+	Filter               string `json:"filter"`
+	MatchGroup           bool   `json:"matchGroup"`
+	MatchGroupDN         string `json:"matchGroupDN"`
+	MatchGroupMemberAttr string `json:"matchGroupMemberAttr"`
+	MatchGroupIterate    bool   `json:"matchGroupIterate"`
+}
+
+// LDAPSettings struct, the enzi LDAP configuration. The server settings are
+// embedded as the main server, AdditionalDomains hold the servers of other domains
+type LDAPSettings struct {
+	LDAPServer
+	RecoveryAdminUsername string                 `json:"recoveryAdminUsername"`
+	RecoveryAdminPassword string                 `json:"recoveryAdminPassword,omitempty"`
+	AdditionalDomains     []LDAPServer           `json:"additionalDomains"`
+	UserSearchConfigs     []LDAPUserSearchConfig `json:"userSearchConfigs"`
+	SyncSchedule          string                 `json:"syncSchedule"`
+	JITUserProvisioning   bool                   `json:"jitUserProvisioning"`
+}
+
+// LDAPTestLogin struct, credentials checked against not yet saved LDAP settings
+type LDAPTestLogin struct {
+	Username     string       `json:"username"`
+	Password     string       `json:"password"`
+	LDAPSettings LDAPSettings `json:"ldapSettings"`
+}
+
+// ResponseLDAPTestLogin struct
+type ResponseLDAPTestLogin struct {
+	Logs []string `json:"logs"`
+}
+
+// ReadAuthConfig retrieves the enzi authentication backend
+func (c *Client) ReadAuthConfig(ctx context.Context) (AuthConfig, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createEnziUrl("config/auth"), nil)
+	if err != nil {
+		return AuthConfig{}, fmt.Errorf("reading auth config failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return AuthConfig{}, fmt.Errorf("reading auth config failed. %w", err)
+	}
+
+	cfg := AuthConfig{}
+	if err := json.Unmarshal(body, &cfg); err != nil {
+		return AuthConfig{}, fmt.Errorf("reading auth config failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return cfg, nil
+}
+
+// UpdateAuthConfig switches the enzi authentication backend
+func (c *Client) UpdateAuthConfig(ctx context.Context, cfg AuthConfig) (AuthConfig, error) {
+	if cfg.Backend == "" {
+		return AuthConfig{}, fmt.Errorf("updating auth config failed. %w: empty backend", ErrEmptyStruct)
+	}
+	body, err := json.Marshal(cfg)
+	if err != nil {
+		return AuthConfig{}, fmt.Errorf("updating auth config failed. %w: %s", ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.createEnziUrl("config/auth"), bytes.NewBuffer(body))
+	if err != nil {
+		return AuthConfig{}, fmt.Errorf("updating auth config failed. %w: %s", ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return AuthConfig{}, fmt.Errorf("updating auth config failed. %w", err)
+	}
+
+	if err := json.Unmarshal(resBody, &cfg); err != nil {
+		return AuthConfig{}, fmt.Errorf("updating auth config failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return cfg, nil
+}
+
+// ReadLDAPSettings retrieves the enzi LDAP settings, passwords are never returned
+func (c *Client) ReadLDAPSettings(ctx context.Context) (LDAPSettings, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createEnziUrl("config/auth/ldap"), nil)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("reading LDAP settings failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("reading LDAP settings failed. %w", err)
+	}
+
+	s := LDAPSettings{}
+	if err := json.Unmarshal(body, &s); err != nil {
+		return LDAPSettings{}, fmt.Errorf("reading LDAP settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return s, nil
+}
+
+// UpdateLDAPSettings replaces the enzi LDAP settings
+func (c *Client) UpdateLDAPSettings(ctx context.Context, s LDAPSettings) (LDAPSettings, error) {
+	if s.ServerURL == "" {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: empty server URL", ErrEmptyStruct)
+	}
+	body, err := json.Marshal(s)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: %s", ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.createEnziUrl("config/auth/ldap"), bytes.NewBuffer(body))
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: %s", ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w", err)
+	}
+
+	updated := LDAPSettings{}
+	if err := json.Unmarshal(resBody, &updated); err != nil {
+		return LDAPSettings{}, fmt.Errorf("updating LDAP settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return updated, nil
+}
+
+// TestLDAPLogin tries to log in with the given LDAP settings without saving them
+func (c *Client) TestLDAPLogin(ctx context.Context, login LDAPTestLogin) (ResponseLDAPTestLogin, error) {
+	if login.Username == "" {
+		return ResponseLDAPTestLogin{}, fmt.Errorf("testing LDAP login failed. %w: empty username", ErrEmptyStruct)
+	}
+	body, err := json.Marshal(login)
+	if err != nil {
+		return ResponseLDAPTestLogin{}, fmt.Errorf("testing LDAP login of %s failed. %w: %s", login.Username, ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createEnziUrl("config/auth/ldap/tryLogin"), bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseLDAPTestLogin{}, fmt.Errorf("testing LDAP login of %s failed. %w: %s", login.Username, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseLDAPTestLogin{}, fmt.Errorf("testing LDAP login of %s failed. %w", login.Username, err)
+	}
+
+	res := ResponseLDAPTestLogin{}
+	if err := json.Unmarshal(resBody, &res); err != nil {
+		return ResponseLDAPTestLogin{}, fmt.Errorf("testing LDAP login of %s failed. %w: %s", login.Username, ErrUnmarshaling, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,107 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testLDAPStruct struct {
+	server           *httptest.Server
+	expectedResponse client.LDAPSettings
+	expectedErr      error
+}
+
+func TestUpdateLDAPSettingsSuccess(t *testing.T) {
+	settings := client.LDAPSettings{
+		LDAPServer: client.LDAPServer{
+			ServerURL:      "ldaps://ldap.example.org",
+			ReaderDN:       "cn=reader,dc=example,dc=org",
+			ReaderPassword: "secret",
+		},
+		AdditionalDomains: []client.LDAPServer{{
+			Domain:    "eu.example.org",
+			ServerURL: "ldaps://ldap.eu.example.org",
+		}},
+		UserSearchConfigs: []client.LDAPUserSearchConfig{{
+			BaseDN:       "ou=people,dc=example,dc=org",
+			UsernameAttr: "uid",
+		}},
+		SyncSchedule:        "@hourly",
+		JITUserProvisioning: true,
+	}
+	tc := testLDAPStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/enzi/v0/config/auth/ldap" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			got := client.LDAPSettings{}
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Error(err)
+			}
+			if got.ReaderPassword != "secret" || got.AdditionalDomains[0].Domain != "eu.example.org" {
+				t.Errorf("unexpected settings sent (%+v)", got)
+			}
+			// MSR never returns the passwords
+			got.ReaderPassword = ""
+			body, err := json.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(body); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedErr: nil,
+	}
+	tc.expectedResponse = settings
+	tc.expectedResponse.ReaderPassword = ""
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateLDAPSettings(ctx, settings)
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestTestLDAPLoginRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/enzi/v0/config/auth/ldap/tryLogin" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		if _, err := w.Write([]byte(`{"errors":[{"code":"INVALID_LOGIN","message":"invalid credentials"}]}`)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.TestLDAPLogin(ctx, client.LDAPTestLogin{Username: "jdoe", Password: "wrong"})
+	if !errors.Is(err, client.ErrResponseError) {
+		t.Errorf("expected (%v), got (%v)", client.ErrResponseError, err)
+	}
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.Errors[0].Code != "INVALID_LOGIN" {
+		t.Errorf("expected INVALID_LOGIN API error, got (%v)", err)
+	}
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// ResponseLogin struct
+type ResponseLogin struct {
+	SessionToken string `json:"sessionToken"`
+}
+
+// session is a login session, its token is replaced when the session is renewed
+type session struct {
+	mu    sync.Mutex
+	token string
+}
+
+func (s *session) get() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// Login exchanges the client username and password for a session token once,
+// all following requests use bearer auth with that token instead of basicauth.
+// The credentials are kept to renew the session when it expires.
+func (c *Client) Login(ctx context.Context) error {
+	if c.Creds.Token != "" || c.session != nil {
+		return nil
+	}
+	token, err := c.login(ctx)
+	if err != nil {
+		return err
+	}
+	c.session = &session{token: token}
+
+	return nil
+}
+
+// renewSession logs in again unless another request already replaced the stale token
+func (c *Client) renewSession(ctx context.Context, stale string) (string, error) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	if c.session.token != stale {
+		return c.session.token, nil
+	}
+	token, err := c.login(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.session.token = token
+
+	return token, nil
+}
+
+// login requests a session token with basicauth, never with the session it replaces
+func (c *Client) login(ctx context.Context) (string, error) {
+	body, err := json.Marshal(AuthStruct{Username: c.Creds.Username, Password: c.Creds.Password})
+	if err != nil {
+		return "", fmt.Errorf("login of user %s failed. %w: %s", c.Creds.Username, ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createEnziUrl("id/login"), bytes.NewBuffer(body))
+	if err != nil {
+		return "", fmt.Errorf("login of user %s failed. %w: %s", c.Creds.Username, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, _, err := c.send(req, "")
+	if err != nil {
+		return "", fmt.Errorf("login of user %s failed. %w", c.Creds.Username, err)
+	}
+
+	login := ResponseLogin{}
+	if err := json.Unmarshal(resBody, &login); err != nil {
+		return "", fmt.Errorf("login of user %s failed. %w: %s", c.Creds.Username, ErrUnmarshaling, err)
+	}
+	if login.SessionToken == "" {
+		return "", fmt.Errorf("login of user %s failed. %w", c.Creds.Username, ErrEmptyResError)
+	}
+
+	return login.SessionToken, nil
+}
@@ -0,0 +1,154 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testLoginStruct struct {
+	server      *httptest.Server
+	expectedErr error
+}
+
+func TestLoginUsesBearerAuthAfterwards(t *testing.T) {
+	logins := 0
+	tc := testLoginStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/enzi/v0/id/login" {
+				logins++
+				w.WriteHeader(http.StatusOK)
+				if _, err := w.Write([]byte(`{"sessionToken":"fake-session"}`)); err != nil {
+					t.Error(err)
+				}
+				return
+			}
+			if _, _, ok := r.BasicAuth(); ok {
+				t.Error("expected no basic auth after login")
+			}
+			if r.Header.Get("Authorization") != "Bearer fake-session" {
+				t.Errorf("expected bearer auth, got (%s)", r.Header.Get("Authorization"))
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(`{"error": "", "healthy":true}`)); err != nil {
+				t.Error(err)
+			}
+		})),
+		expectedErr: nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if err := testClient.Login(ctx); !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := testClient.IsHealthy(ctx); !errors.Is(err, tc.expectedErr) {
+			t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+		}
+	}
+	if logins != 1 {
+		t.Errorf("expected a single login, got (%d)", logins)
+	}
+}
+
+func TestLoginUnauthorized(t *testing.T) {
+	tc := testLoginStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})),
+		expectedErr: client.ErrUnauthorizedReq,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "wrongpass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if err := testClient.Login(ctx); !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestTokenClientEmptyToken(t *testing.T) {
+	if _, err := client.NewDefaultTokenClient("http://localhost", ""); !errors.Is(err, client.ErrEmptyClientArgs) {
+		t.Errorf("expected (%v), got (%v)", client.ErrEmptyClientArgs, err)
+	}
+}
+
+func TestLoginRenewsExpiredSession(t *testing.T) {
+	logins := 0
+	tc := testLoginStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/enzi/v0/id/login" {
+				logins++
+				w.WriteHeader(http.StatusOK)
+				if _, err := fmt.Fprintf(w, `{"sessionToken":"fake-session-%d"}`, logins); err != nil {
+					t.Error(err)
+				}
+				return
+			}
+			// The first session expired before it got used
+			if r.Header.Get("Authorization") != "Bearer fake-session-2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(`{"error": "", "healthy":true}`)); err != nil {
+				t.Error(err)
+			}
+		})),
+		expectedErr: nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if err := testClient.Login(ctx); !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+	// Copies of the client share the renewed session
+	copied := testClient
+	for _, c := range []client.Client{testClient, copied} {
+		if _, err := c.IsHealthy(ctx); !errors.Is(err, tc.expectedErr) {
+			t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+		}
+	}
+	if logins != 2 {
+		t.Errorf("expected a login and a single renewal, got (%d) logins", logins)
+	}
+}
+
+func TestTokenClientNotRenewed(t *testing.T) {
+	tc := testLoginStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/enzi/v0/id/login" {
+				t.Error("a token client should never log in")
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+		})),
+		expectedErr: client.ErrUnauthorizedReq,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultTokenClient(tc.server.URL, "revoked-token")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if err := testClient.Login(ctx); err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+	if _, err := testClient.IsHealthy(ctx); !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// MirroringPolicyKind is the direction of a repository mirroring policy
+type MirroringPolicyKind string
+
+const (
+	// PushMirroring policies push tags of the local repo to a remote registry
+	PushMirroring MirroringPolicyKind = "pushMirroringPolicies"
+	// PollMirroring policies poll a remote registry and pull its tags into the local repo
+	PollMirroring MirroringPolicyKind = "pollMirroringPolicies"
+)
+
+// MirroringPolicy struct
+type MirroringPolicy struct {
+	Enabled             bool         `json:"enabled"`
+	Rules               []PolicyRule `json:"rules"`
+	RemoteHost          string       `json:"remoteHost"`
+	RemoteRepository    string       `json:"remoteRepository"`
+	RemoteCA            string       `json:"remoteCA,omitempty"`
+	SkipTLSVerification bool         `json:"skipTLSVerification"`
+	TagTemplate         string       `json:"tagTemplate,omitempty"`
+	Username            string       `json:"username,omitempty"`
+	Password            string       `json:"password,omitempty"`
+	AuthToken           string       `json:"authToken,omitempty"`
+}
+
+// MirroringStatus is the outcome of the last mirroring run of a policy
+type MirroringStatus struct {
+	Code   string `json:"code"`
+	Detail string `json:"detail"`
+}
+
+// ResponseMirroringPolicy struct, credentials are never returned by MSR
+type ResponseMirroringPolicy struct {
+	ID                  string          `json:"id"`
+	Enabled             bool            `json:"enabled"`
+	Rules               []PolicyRule    `json:"rules"`
+	LocalRepository     string          `json:"localRepository"`
+	RemoteHost          string          `json:"remoteHost"`
+	RemoteRepository    string          `json:"remoteRepository"`
+	RemoteCA            string          `json:"remoteCA"`
+	SkipTLSVerification bool            `json:"skipTLSVerification"`
+	TagTemplate         string          `json:"tagTemplate"`
+	Username            string          `json:"username"`
+	LastMirroredAt      time.Time       `json:"lastMirroredAt"`
+	LastStatus          MirroringStatus `json:"lastStatus"`
+}
+
+func (c *Client) createMirroringPolicyUrl(kind MirroringPolicyKind, repoName string) string {
+	return fmt.Sprintf("%s/%s/%s", c.createMsrUrl("repositories"), repoName, kind)
+}
+
+// CreateMirroringPolicy creates a push or poll mirroring policy on a repo in MSR
+func (c *Client) CreateMirroringPolicy(ctx context.Context, kind MirroringPolicyKind, repoName string, policy MirroringPolicy) (ResponseMirroringPolicy, error) {
+	if policy.RemoteHost == "" || policy.RemoteRepository == "" {
+		return ResponseMirroringPolicy{}, fmt.Errorf("creating %s failed. %w: remote host and repository are required", kind, ErrEmptyStruct)
+	}
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("creating %s on repo %s failed. %w: %s", kind, repoName, ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createMirroringPolicyUrl(kind, repoName), bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("creating %s on repo %s failed. %w: %s", kind, repoName, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("creating %s on repo %s failed. %w", kind, repoName, err)
+	}
+
+	resPolicy := ResponseMirroringPolicy{}
+	if err := json.Unmarshal(resBody, &resPolicy); err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("creating %s on repo %s failed. %w: %s", kind, repoName, ErrUnmarshaling, err)
+	}
+
+	return resPolicy, nil
+}
+
+// ReadMirroringPolicy retrieves a single push or poll mirroring policy of a repo
+func (c *Client) ReadMirroringPolicy(ctx context.Context, kind MirroringPolicyKind, repoName string, id string) (ResponseMirroringPolicy, error) {
+	url := fmt.Sprintf("%s/%s", c.createMirroringPolicyUrl(kind, repoName), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("reading %s %s of repo %s failed. %w: %s", kind, id, repoName, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("reading %s %s of repo %s failed. %w", kind, id, repoName, err)
+	}
+
+	policy := ResponseMirroringPolicy{}
+	if err := json.Unmarshal(body, &policy); err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("reading %s %s of repo %s failed. %w: %s", kind, id, repoName, ErrUnmarshaling, err)
+	}
+
+	return policy, nil
+}
+
+// UpdateMirroringPolicy updates a push or poll mirroring policy of a repo
+func (c *Client) UpdateMirroringPolicy(ctx context.Context, kind MirroringPolicyKind, repoName string, id string, policy MirroringPolicy) (ResponseMirroringPolicy, error) {
+	if policy.RemoteHost == "" || policy.RemoteRepository == "" {
+		return ResponseMirroringPolicy{}, fmt.Errorf("updating %s %s failed. %w: remote host and repository are required", kind, id, ErrEmptyStruct)
+	}
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("updating %s %s of repo %s failed. %w: %s", kind, id, repoName, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s", c.createMirroringPolicyUrl(kind, repoName), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("updating %s %s of repo %s failed. %w: %s", kind, id, repoName, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("updating %s %s of repo %s failed. %w", kind, id, repoName, err)
+	}
+
+	resPolicy := ResponseMirroringPolicy{}
+	if err := json.Unmarshal(resBody, &resPolicy); err != nil {
+		return ResponseMirroringPolicy{}, fmt.Errorf("updating %s %s of repo %s failed. %w: %s", kind, id, repoName, ErrUnmarshaling, err)
+	}
+
+	return resPolicy, nil
+}
+
+// DeleteMirroringPolicy deletes a push or poll mirroring policy of a repo
+func (c *Client) DeleteMirroringPolicy(ctx context.Context, kind MirroringPolicyKind, repoName string, id string) error {
+	url := fmt.Sprintf("%s/%s", c.createMirroringPolicyUrl(kind, repoName), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting %s %s of repo %s failed. %w: %s", kind, id, repoName, ErrRequestCreation, err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("deleting %s %s of repo %s failed. %w", kind, id, repoName, err)
+	}
+
+	return nil
+}
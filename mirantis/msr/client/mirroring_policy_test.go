@@ -0,0 +1,177 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+// fakeMirroringServer is an in memory stand in for the MSR mirroring policy endpoints
+type fakeMirroringServer struct {
+	mu       sync.Mutex
+	nextID   int
+	policies map[string]client.ResponseMirroringPolicy
+}
+
+func newFakeMirroringServer(t *testing.T) *httptest.Server {
+	f := &fakeMirroringServer{policies: map[string]client.ResponseMirroringPolicy{}}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		// /api/v0/repositories/{ns}/{repo}/{kind}[/{id}]
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v0/repositories/"), "/")
+		if len(parts) < 3 || (parts[2] != string(client.PushMirroring) && parts[2] != string(client.PollMirroring)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		repoName := parts[0] + "/" + parts[1]
+		kind := parts[2]
+
+		writeJSON := func(status int, v interface{}) {
+			w.WriteHeader(status)
+			if err := json.NewEncoder(w).Encode(v); err != nil {
+				t.Error(err)
+			}
+		}
+		notFound := func() {
+			writeJSON(http.StatusNotFound, client.ResponseError{Errors: []client.Errors{{Code: "NO_SUCH_POLICY", Message: "no such policy"}}})
+		}
+		fromReq := func(id string) (client.ResponseMirroringPolicy, bool) {
+			p := client.MirroringPolicy{}
+			if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+				writeJSON(http.StatusBadRequest, client.ResponseError{Errors: []client.Errors{{Code: "BAD", Message: err.Error()}}})
+				return client.ResponseMirroringPolicy{}, false
+			}
+			return client.ResponseMirroringPolicy{
+				ID:                  id,
+				Enabled:             p.Enabled,
+				Rules:               p.Rules,
+				LocalRepository:     repoName,
+				RemoteHost:          p.RemoteHost,
+				RemoteRepository:    p.RemoteRepository,
+				RemoteCA:            p.RemoteCA,
+				SkipTLSVerification: p.SkipTLSVerification,
+				TagTemplate:         p.TagTemplate,
+				Username:            p.Username,
+			}, true
+		}
+
+		switch {
+		case len(parts) == 3 && r.Method == http.MethodPost:
+			f.nextID++
+			id := fmt.Sprintf("%s-%d", kind, f.nextID)
+			p, ok := fromReq(id)
+			if !ok {
+				return
+			}
+			f.policies[id] = p
+			writeJSON(http.StatusCreated, p)
+		case len(parts) == 4 && r.Method == http.MethodGet:
+			p, ok := f.policies[parts[3]]
+			if !ok {
+				notFound()
+				return
+			}
+			writeJSON(http.StatusOK, p)
+		case len(parts) == 4 && r.Method == http.MethodPut:
+			if _, ok := f.policies[parts[3]]; !ok {
+				notFound()
+				return
+			}
+			p, ok := fromReq(parts[3])
+			if !ok {
+				return
+			}
+			f.policies[parts[3]] = p
+			writeJSON(http.StatusOK, p)
+		case len(parts) == 4 && r.Method == http.MethodDelete:
+			if _, ok := f.policies[parts[3]]; !ok {
+				notFound()
+				return
+			}
+			delete(f.policies, parts[3])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
+
+func TestMirroringPolicyLifecycle(t *testing.T) {
+	for _, kind := range []client.MirroringPolicyKind{client.PushMirroring, client.PollMirroring} {
+		t.Run(string(kind), func(t *testing.T) {
+			server := newFakeMirroringServer(t)
+			defer server.Close()
+			testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+			if err != nil {
+				t.Fatal("couldn't create test client")
+			}
+			ctx := context.Background()
+
+			policy := client.MirroringPolicy{
+				Enabled:          true,
+				RemoteHost:       "https://msr.eu.example.com",
+				RemoteRepository: "prod/app",
+				Username:         "mirror",
+				Password:         "secret",
+				Rules: []client.PolicyRule{
+					{Field: "tag", Operator: "sw", Values: []string{"v"}},
+				},
+			}
+			created, err := testClient.CreateMirroringPolicy(ctx, kind, "dev/app", policy)
+			if err != nil {
+				t.Fatalf("expected (%v), got (%v)", nil, err)
+			}
+			if created.ID == "" || created.RemoteRepository != policy.RemoteRepository {
+				t.Errorf("unexpected created policy (%+v)", created)
+			}
+
+			policy.SkipTLSVerification = true
+			updated, err := testClient.UpdateMirroringPolicy(ctx, kind, "dev/app", created.ID, policy)
+			if err != nil {
+				t.Fatalf("expected (%v), got (%v)", nil, err)
+			}
+			if !updated.SkipTLSVerification {
+				t.Errorf("expected update to be applied, got (%+v)", updated)
+			}
+
+			read, err := testClient.ReadMirroringPolicy(ctx, kind, "dev/app", created.ID)
+			if err != nil {
+				t.Fatalf("expected (%v), got (%v)", nil, err)
+			}
+			if read.ID != created.ID || !read.SkipTLSVerification {
+				t.Errorf("unexpected read policy (%+v)", read)
+			}
+
+			if err := testClient.DeleteMirroringPolicy(ctx, kind, "dev/app", created.ID); err != nil {
+				t.Fatalf("expected (%v), got (%v)", nil, err)
+			}
+			if _, err := testClient.ReadMirroringPolicy(ctx, kind, "dev/app", created.ID); !errors.Is(err, client.ErrNotFound) {
+				t.Errorf("expected (%v), got (%v)", client.ErrNotFound, err)
+			}
+		})
+	}
+}
+
+func TestCreateMirroringPolicyEmpty(t *testing.T) {
+	server := newFakeMirroringServer(t)
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Fatal("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.CreateMirroringPolicy(ctx, client.PushMirroring, "dev/app", client.MirroringPolicy{})
+	if !errors.Is(err, client.ErrEmptyStruct) {
+		t.Errorf("expected (%v), got (%v)", client.ErrEmptyStruct, err)
+	}
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ResponseOrgMember struct
+type ResponseOrgMember struct {
+	IsAdmin  bool            `json:"isAdmin"`
+	IsPublic bool            `json:"isPublic"`
+	Member   ResponseAccount `json:"member"`
+}
+
+// AddOrgMember adds a user to an org, or updates its admin flag if it already is a member
+func (c *Client) AddOrgMember(ctx context.Context, org string, user string, isAdmin bool) (ResponseOrgMember, error) {
+	if org == "" || user == "" {
+		return ResponseOrgMember{}, fmt.Errorf("adding member to org failed. %w: org '%s', user '%s'", ErrEmptyStruct, org, user)
+	}
+	body, err := json.Marshal(map[string]bool{"isAdmin": isAdmin})
+	if err != nil {
+		return ResponseOrgMember{}, fmt.Errorf("adding member %s to org %s failed. %w: %s", user, org, ErrMarshaling, err)
+	}
+	url := c.createEnziUrl(fmt.Sprintf("accounts/%s/members/%s", org, user))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseOrgMember{}, fmt.Errorf("adding member %s to org %s failed. %w: %s", user, org, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseOrgMember{}, fmt.Errorf("adding member %s to org %s failed. %w", user, org, err)
+	}
+
+	member := ResponseOrgMember{}
+	if err := json.Unmarshal(resBody, &member); err != nil {
+		return ResponseOrgMember{}, fmt.Errorf("adding member %s to org %s failed. %w: %s", user, org, ErrUnmarshaling, err)
+	}
+
+	return member, nil
+}
+
+// ReadOrgMember retrieves the membership of a user in an org
+func (c *Client) ReadOrgMember(ctx context.Context, org string, user string) (ResponseOrgMember, error) {
+	url := c.createEnziUrl(fmt.Sprintf("accounts/%s/members/%s", org, user))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponseOrgMember{}, fmt.Errorf("reading member %s of org %s failed. %w: %s", user, org, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponseOrgMember{}, fmt.Errorf("reading member %s of org %s failed. %w", user, org, err)
+	}
+
+	member := ResponseOrgMember{}
+	if err := json.Unmarshal(body, &member); err != nil {
+		return ResponseOrgMember{}, fmt.Errorf("reading member %s of org %s failed. %w: %s", user, org, ErrUnmarshaling, err)
+	}
+
+	return member, nil
+}
+
+// DeleteOrgMember removes a user from an org, along with all of the org teams
+func (c *Client) DeleteOrgMember(ctx context.Context, org string, user string) error {
+	url := c.createEnziUrl(fmt.Sprintf("accounts/%s/members/%s", org, user))
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting member %s of org %s failed. %w: %s", user, org, ErrRequestCreation, err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("deleting member %s of org %s failed. %w", user, org, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testOrgMemberStruct struct {
+	server           *httptest.Server
+	expectedResponse client.ResponseOrgMember
+	expectedErr      error
+}
+
+func TestAddOrgMemberSuccess(t *testing.T) {
+	member := client.ResponseOrgMember{
+		IsAdmin: true,
+		Member:  client.ResponseAccount{Name: "jdoe", ID: "fake-user-id", IsActive: true},
+	}
+	mMember, err := json.Marshal(member)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testOrgMemberStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/enzi/v0/accounts/engineering/members/jdoe" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			got := map[string]bool{}
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Error(err)
+			}
+			if !got["isAdmin"] {
+				t.Errorf("expected isAdmin to be sent, got (%+v)", got)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mMember); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: member,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.AddOrgMember(ctx, "engineering", "jdoe", true)
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadOrgMemberNotFound(t *testing.T) {
+	tc := testOrgMemberStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})),
+		expectedResponse: client.ResponseOrgMember{},
+		expectedErr:      client.ErrNotFound,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadOrgMember(ctx, "engineering", "jdoe")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
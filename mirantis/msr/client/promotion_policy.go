@@ -0,0 +1,169 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PolicyRule is a single condition a tag has to satisfy for a repository policy to apply to it
+type PolicyRule struct {
+	Field    string   `json:"field"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+// PolicyRuleFields are the tag properties repository policy rules can match on
+var PolicyRuleFields = []string{
+	"tag",
+	"license.name",
+	"component.name",
+	"vulnerability_all",
+	"vulnerability_critical",
+	"vulnerability_major",
+	"vulnerability_minor",
+	"updated_at",
+}
+
+// PolicyRuleOperators are the comparisons repository policy rules support
+var PolicyRuleOperators = []string{
+	"eq", "neq", "gt", "gte", "lt", "lte", "sw", "ew", "c", "nc", "oneof", "noneof", "matches",
+}
+
+// PromotionPolicy struct
+type PromotionPolicy struct {
+	Enabled          bool         `json:"enabled"`
+	Rules            []PolicyRule `json:"rules"`
+	TagTemplate      string       `json:"tagTemplate"`
+	TargetRepository string       `json:"targetRepository"`
+}
+
+// ResponsePromotionPolicy struct
+type ResponsePromotionPolicy struct {
+	ID               string       `json:"id"`
+	SourceRepository string       `json:"sourceRepository"`
+	TargetRepository string       `json:"targetRepository"`
+	TagTemplate      string       `json:"tagTemplate"`
+	Enabled          bool         `json:"enabled"`
+	Rules            []PolicyRule `json:"rules"`
+	CreatedAt        time.Time    `json:"createdAt"`
+	UpdatedAt        time.Time    `json:"updatedAt"`
+	LastPromotedAt   time.Time    `json:"lastPromotedAt"`
+}
+
+// CreatePromotionPolicy creates a promotion policy on a repo in MSR
+func (c *Client) CreatePromotionPolicy(ctx context.Context, repoName string, policy PromotionPolicy) (ResponsePromotionPolicy, error) {
+	if policy.TargetRepository == "" {
+		return ResponsePromotionPolicy{}, fmt.Errorf("creating promotion policy failed. %w: %+v", ErrEmptyStruct, policy)
+	}
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("creating promotion policy on repo %s failed. %w: %s", repoName, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s/promotionPolicies", c.createMsrUrl("repositories"), repoName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("creating promotion policy on repo %s failed. %w: %s", repoName, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("creating promotion policy on repo %s failed. %w", repoName, err)
+	}
+
+	resPolicy := ResponsePromotionPolicy{}
+	if err := json.Unmarshal(resBody, &resPolicy); err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("creating promotion policy on repo %s failed. %w: %s", repoName, ErrUnmarshaling, err)
+	}
+
+	return resPolicy, nil
+}
+
+// ReadPromotionPolicies retrieves all the promotion policies of a repo
+func (c *Client) ReadPromotionPolicies(ctx context.Context, repoName string) ([]ResponsePromotionPolicy, error) {
+	url := fmt.Sprintf("%s/%s/promotionPolicies", c.createMsrUrl("repositories"), repoName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return []ResponsePromotionPolicy{}, fmt.Errorf("reading promotion policies of repo %s failed. %w: %s", repoName, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return []ResponsePromotionPolicy{}, fmt.Errorf("reading promotion policies of repo %s failed. %w", repoName, err)
+	}
+
+	policies := []ResponsePromotionPolicy{}
+	if err := json.Unmarshal(body, &policies); err != nil {
+		return []ResponsePromotionPolicy{}, fmt.Errorf("reading promotion policies of repo %s failed. %w: %s", repoName, ErrUnmarshaling, err)
+	}
+
+	return policies, nil
+}
+
+// ReadPromotionPolicy retrieves a single promotion policy of a repo
+func (c *Client) ReadPromotionPolicy(ctx context.Context, repoName string, id string) (ResponsePromotionPolicy, error) {
+	url := fmt.Sprintf("%s/%s/promotionPolicies/%s", c.createMsrUrl("repositories"), repoName, id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("reading promotion policy %s of repo %s failed. %w: %s", id, repoName, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("reading promotion policy %s of repo %s failed. %w", id, repoName, err)
+	}
+
+	policy := ResponsePromotionPolicy{}
+	if err := json.Unmarshal(body, &policy); err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("reading promotion policy %s of repo %s failed. %w: %s", id, repoName, ErrUnmarshaling, err)
+	}
+
+	return policy, nil
+}
+
+// UpdatePromotionPolicy updates a promotion policy of a repo
+func (c *Client) UpdatePromotionPolicy(ctx context.Context, repoName string, id string, policy PromotionPolicy) (ResponsePromotionPolicy, error) {
+	if policy.TargetRepository == "" {
+		return ResponsePromotionPolicy{}, fmt.Errorf("updating promotion policy %s failed. %w: %+v", id, ErrEmptyStruct, policy)
+	}
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("updating promotion policy %s of repo %s failed. %w: %s", id, repoName, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s/promotionPolicies/%s", c.createMsrUrl("repositories"), repoName, id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("updating promotion policy %s of repo %s failed. %w: %s", id, repoName, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("updating promotion policy %s of repo %s failed. %w", id, repoName, err)
+	}
+
+	resPolicy := ResponsePromotionPolicy{}
+	if err := json.Unmarshal(resBody, &resPolicy); err != nil {
+		return ResponsePromotionPolicy{}, fmt.Errorf("updating promotion policy %s of repo %s failed. %w: %s", id, repoName, ErrUnmarshaling, err)
+	}
+
+	return resPolicy, nil
+}
+
+// DeletePromotionPolicy deletes a promotion policy of a repo
+func (c *Client) DeletePromotionPolicy(ctx context.Context, repoName string, id string) error {
+	url := fmt.Sprintf("%s/%s/promotionPolicies/%s", c.createMsrUrl("repositories"), repoName, id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting promotion policy %s of repo %s failed. %w: %s", id, repoName, ErrRequestCreation, err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("deleting promotion policy %s of repo %s failed. %w", id, repoName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testPromotionPolicyStruct struct {
+	server           *httptest.Server
+	expectedResponse client.ResponsePromotionPolicy
+	expectedErr      error
+}
+
+func TestCreatePromotionPolicySuccess(t *testing.T) {
+	testPolicy := client.ResponsePromotionPolicy{
+		ID:               "fake-policy-id",
+		SourceRepository: "dev/app",
+		TargetRepository: "prod/app",
+		TagTemplate:      "%n",
+		Enabled:          true,
+		Rules: []client.PolicyRule{
+			{Field: "tag", Operator: "sw", Values: []string{"release-"}},
+		},
+	}
+	mPolicy, err := json.Marshal(testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testPromotionPolicyStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/api/v0/repositories/dev/app/promotionPolicies" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			w.WriteHeader(http.StatusCreated)
+			if _, err := w.Write(mPolicy); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: testPolicy,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreatePromotionPolicy(ctx, "dev/app", client.PromotionPolicy{
+		Enabled:          true,
+		Rules:            testPolicy.Rules,
+		TagTemplate:      "%n",
+		TargetRepository: "prod/app",
+	})
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestCreatePromotionPolicyEmpty(t *testing.T) {
+	tc := testPromotionPolicyStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("no request should be sent for an empty policy")
+		})),
+		expectedResponse: client.ResponsePromotionPolicy{},
+		expectedErr:      client.ErrEmptyStruct,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreatePromotionPolicy(ctx, "dev/app", client.PromotionPolicy{})
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadPromotionPolicyNotFound(t *testing.T) {
+	tc := testPromotionPolicyStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			if _, err := w.Write([]byte(`{"errors":[{"code":"NO_SUCH_POLICY","message":"no such policy"}]}`)); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.ResponsePromotionPolicy{},
+		expectedErr:      client.ErrNotFound,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadPromotionPolicy(ctx, "dev/app", "fake-policy-id")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestDeletePromotionPolicySuccess(t *testing.T) {
+	tc := testPromotionPolicyStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})),
+		expectedErr: nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	err = testClient.DeletePromotionPolicy(ctx, "dev/app", "fake-policy-id")
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// PruningPolicy struct
+type PruningPolicy struct {
+	Enabled bool         `json:"enabled"`
+	Rules   []PolicyRule `json:"rules"`
+}
+
+// ResponsePruningPolicy struct
+type ResponsePruningPolicy struct {
+	ID        string       `json:"id"`
+	Enabled   bool         `json:"enabled"`
+	Rules     []PolicyRule `json:"rules"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+// CreatePruningPolicy creates a pruning policy on a repo in MSR.
+// With initialEvaluation the policy is also applied to the tags already in the repo.
+func (c *Client) CreatePruningPolicy(ctx context.Context, repoName string, policy PruningPolicy, initialEvaluation bool) (ResponsePruningPolicy, error) {
+	if len(policy.Rules) == 0 {
+		return ResponsePruningPolicy{}, fmt.Errorf("creating pruning policy failed. %w: %+v", ErrEmptyStruct, policy)
+	}
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("creating pruning policy on repo %s failed. %w: %s", repoName, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s/pruningPolicies", c.createMsrUrl("repositories"), repoName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("creating pruning policy on repo %s failed. %w: %s", repoName, ErrRequestCreation, err)
+	}
+	q := req.URL.Query()
+	q.Add("initialEvaluation", strconv.FormatBool(initialEvaluation))
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("creating pruning policy on repo %s failed. %w", repoName, err)
+	}
+
+	resPolicy := ResponsePruningPolicy{}
+	if err := json.Unmarshal(resBody, &resPolicy); err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("creating pruning policy on repo %s failed. %w: %s", repoName, ErrUnmarshaling, err)
+	}
+
+	return resPolicy, nil
+}
+
+// ReadPruningPolicy retrieves a single pruning policy of a repo
+func (c *Client) ReadPruningPolicy(ctx context.Context, repoName string, id string) (ResponsePruningPolicy, error) {
+	url := fmt.Sprintf("%s/%s/pruningPolicies/%s", c.createMsrUrl("repositories"), repoName, id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("reading pruning policy %s of repo %s failed. %w: %s", id, repoName, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("reading pruning policy %s of repo %s failed. %w", id, repoName, err)
+	}
+
+	policy := ResponsePruningPolicy{}
+	if err := json.Unmarshal(body, &policy); err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("reading pruning policy %s of repo %s failed. %w: %s", id, repoName, ErrUnmarshaling, err)
+	}
+
+	return policy, nil
+}
+
+// UpdatePruningPolicy updates a pruning policy of a repo.
+// With initialEvaluation the updated policy is also applied to the tags already in the repo.
+func (c *Client) UpdatePruningPolicy(ctx context.Context, repoName string, id string, policy PruningPolicy, initialEvaluation bool) (ResponsePruningPolicy, error) {
+	if len(policy.Rules) == 0 {
+		return ResponsePruningPolicy{}, fmt.Errorf("updating pruning policy %s failed. %w: %+v", id, ErrEmptyStruct, policy)
+	}
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("updating pruning policy %s of repo %s failed. %w: %s", id, repoName, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s/pruningPolicies/%s", c.createMsrUrl("repositories"), repoName, id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("updating pruning policy %s of repo %s failed. %w: %s", id, repoName, ErrRequestCreation, err)
+	}
+	q := req.URL.Query()
+	q.Add("initialEvaluation", strconv.FormatBool(initialEvaluation))
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("updating pruning policy %s of repo %s failed. %w", id, repoName, err)
+	}
+
+	resPolicy := ResponsePruningPolicy{}
+	if err := json.Unmarshal(resBody, &resPolicy); err != nil {
+		return ResponsePruningPolicy{}, fmt.Errorf("updating pruning policy %s of repo %s failed. %w: %s", id, repoName, ErrUnmarshaling, err)
+	}
+
+	return resPolicy, nil
+}
+
+// DeletePruningPolicy deletes a pruning policy of a repo
+func (c *Client) DeletePruningPolicy(ctx context.Context, repoName string, id string) error {
+	url := fmt.Sprintf("%s/%s/pruningPolicies/%s", c.createMsrUrl("repositories"), repoName, id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting pruning policy %s of repo %s failed. %w: %s", id, repoName, ErrRequestCreation, err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("deleting pruning policy %s of repo %s failed. %w", id, repoName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testPruningPolicyStruct struct {
+	server           *httptest.Server
+	expectedResponse client.ResponsePruningPolicy
+	expectedErr      error
+}
+
+func TestCreatePruningPolicyInitialEvaluation(t *testing.T) {
+	testPolicy := client.ResponsePruningPolicy{
+		ID:      "fake-policy-id",
+		Enabled: true,
+		Rules: []client.PolicyRule{
+			{Field: "tag", Operator: "sw", Values: []string{"ci-"}},
+		},
+	}
+	mPolicy, err := json.Marshal(testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testPruningPolicyStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("initialEvaluation") != "true" {
+				t.Errorf("expected initialEvaluation=true, got %s", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusCreated)
+			if _, err := w.Write(mPolicy); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: testPolicy,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreatePruningPolicy(ctx, "dev/app", client.PruningPolicy{Enabled: true, Rules: testPolicy.Rules}, true)
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestCreatePruningPolicyNoRules(t *testing.T) {
+	tc := testPruningPolicyStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("no request should be sent for a policy without rules")
+		})),
+		expectedResponse: client.ResponsePruningPolicy{},
+		expectedErr:      client.ErrEmptyStruct,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreatePruningPolicy(ctx, "dev/app", client.PruningPolicy{Enabled: true}, false)
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadPruningPolicyNotFound(t *testing.T) {
+	tc := testPruningPolicyStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})),
+		expectedResponse: client.ResponsePruningPolicy{},
+		expectedErr:      client.ErrNotFound,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadPruningPolicy(ctx, "dev/app", "fake-policy-id")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
@@ -6,8 +6,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 )
 
+// Visibilities of a repository
+const (
+	RepoVisibilityPublic  = "public"
+	RepoVisibilityPrivate = "private"
+)
+
+// RepoVisibilities are the visibilities a repository can have
+var RepoVisibilities = []string{RepoVisibilityPublic, RepoVisibilityPrivate}
+
 type CreateRepo struct {
 	ImmutableTags    bool   `json:"immutableTags"`
 	LongDescription  string `json:"longDescription"`
@@ -91,6 +101,46 @@ func (c *Client) ReadRepo(ctx context.Context, repoName string) (ResponseRepo, e
 	return repo, nil
 }
 
+// ReadRepos retrieves all the repos of a namespace, or of every namespace when it is empty
+func (c *Client) ReadRepos(ctx context.Context, namespace string) ([]ResponseRepo, error) {
+	url := c.createMsrUrl("repositories")
+	if namespace != "" {
+		url = fmt.Sprintf("%s/%s", url, namespace)
+	}
+	repos := []ResponseRepo{}
+	pageStart := ""
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return []ResponseRepo{}, fmt.Errorf("reading repos of '%s' failed. %w: %s", namespace, ErrRequestCreation, err)
+		}
+		q := req.URL.Query()
+		q.Add("pageSize", strconv.Itoa(MSRPAGESIZE))
+		q.Add("pageStart", pageStart)
+		req.URL.RawQuery = q.Encode()
+
+		body, header, err := c.doRequestWithHeader(req)
+		if err != nil {
+			return []ResponseRepo{}, fmt.Errorf("reading repos of '%s' failed. %w", namespace, err)
+		}
+
+		page := struct {
+			Repositories []ResponseRepo `json:"repositories"`
+		}{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return []ResponseRepo{}, fmt.Errorf("reading repos of '%s' failed. %w: %s", namespace, ErrUnmarshaling, err)
+		}
+		repos = append(repos, page.Repositories...)
+
+		pageStart = header.Get(MSRNEXTPAGEHEADER)
+		if pageStart == "" || len(page.Repositories) == 0 {
+			break
+		}
+	}
+
+	return repos, nil
+}
+
 // UpdateRepo updates a repo in the MSR endpoint
 func (c *Client) UpdateRepo(ctx context.Context, repoName string, repo UpdateRepo) (ResponseRepo, error) {
 	if (repo == UpdateRepo{}) {
@@ -348,3 +348,49 @@ func TestUpdateRepoFailed(t *testing.T) {
 		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
 	}
 }
+
+func TestReadReposPaginated(t *testing.T) {
+	pages := map[string]string{
+		"":      `{"repositories":[{"name":"api","namespace":"engineering"}]}`,
+		"page2": `{"repositories":[{"name":"web","namespace":"engineering"}]}`,
+	}
+	tc := struct {
+		server           *httptest.Server
+		expectedResponse []client.ResponseRepo
+		expectedErr      error
+	}{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v0/repositories/engineering" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			start := r.URL.Query().Get("pageStart")
+			if start == "" {
+				w.Header().Set(client.MSRNEXTPAGEHEADER, "page2")
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(pages[start])); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: []client.ResponseRepo{
+			{Name: "api", Namespace: "engineering"},
+			{Name: "web", Namespace: "engineering"},
+		},
+		expectedErr: nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadRepos(ctx, "engineering")
+
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected resp: (%+v),\n got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected error: (%v),\n got (%v)", tc.expectedErr, err)
+	}
+}
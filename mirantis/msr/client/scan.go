@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Scan statuses reported in last_scan_status, they grow as the scan progresses
+// so the smallest one of several platforms is the worst
+const (
+	ScanStatusFailed = iota
+	ScanStatusUnscanned
+	ScanStatusScanning
+	ScanStatusPending
+	ScanStatusScanned
+	ScanStatusChecking
+	ScanStatusCompleted
+)
+
+// Vulnerability severities, MSR buckets the vulnerabilities by their CVSS score
+const (
+	SeverityCritical = "critical"
+	SeverityMajor    = "major"
+	SeverityMinor    = "minor"
+)
+
+// ScanVuln is a single vulnerability found in a component
+type ScanVuln struct {
+	ID      string  `json:"id"`
+	CVSS    float64 `json:"cvss"`
+	Summary string  `json:"summary"`
+}
+
+// Severity of the vulnerability the way MSR counts it
+func (v ScanVuln) Severity() string {
+	switch {
+	case v.CVSS >= 7:
+		return SeverityCritical
+	case v.CVSS >= 4:
+		return SeverityMajor
+	default:
+		return SeverityMinor
+	}
+}
+
+// ScanComponent is a software component found in an image layer
+type ScanComponent struct {
+	Component string `json:"component"`
+	Version   string `json:"version"`
+	Vulns     []struct {
+		Vuln ScanVuln `json:"vuln"`
+	} `json:"vulns"`
+}
+
+// ScanLayer is the scan result of a single image layer
+type ScanLayer struct {
+	Digest     string          `json:"digest"`
+	Components []ScanComponent `json:"components"`
+}
+
+// ScanSummary is the vulnerability scan result of an image, one per platform of the tag
+type ScanSummary struct {
+	Namespace        string      `json:"namespace"`
+	RepoName         string      `json:"reponame"`
+	Tag              string      `json:"tag"`
+	Architecture     string      `json:"architecture"`
+	OS               string      `json:"os"`
+	Critical         int         `json:"critical"`
+	Major            int         `json:"major"`
+	Minor            int         `json:"minor"`
+	LastScanStatus   int         `json:"last_scan_status"`
+	CheckCompletedAt time.Time   `json:"check_completed_at"`
+	ShouldRescan     bool        `json:"should_rescan"`
+	Layers           []ScanLayer `json:"layers"`
+}
+
+// ReadScanSummary retrieves the detailed vulnerability scan results of a tag
+func (c *Client) ReadScanSummary(ctx context.Context, repoName string, tag string) ([]ScanSummary, error) {
+	url := fmt.Sprintf("%s/%s/%s", c.createMsrUrl("imagescan/scansummary/repositories"), repoName, tag)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return []ScanSummary{}, fmt.Errorf("reading scan summary of %s:%s failed. %w: %s", repoName, tag, ErrRequestCreation, err)
+	}
+	q := req.URL.Query()
+	q.Add("detailed", "true")
+	req.URL.RawQuery = q.Encode()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return []ScanSummary{}, fmt.Errorf("reading scan summary of %s:%s failed. %w", repoName, tag, err)
+	}
+
+	summaries := []ScanSummary{}
+	if err := json.Unmarshal(body, &summaries); err != nil {
+		return []ScanSummary{}, fmt.Errorf("reading scan summary of %s:%s failed. %w: %s", repoName, tag, ErrUnmarshaling, err)
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+func TestReadScanSummarySuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v0/imagescan/scansummary/repositories/dev/app/v1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`[{"namespace":"dev","reponame":"app","tag":"v1","critical":1,"major":2,"minor":3,
+			"layers":[{"digest":"sha256:l1","components":[{"component":"openssl","version":"1.1.1",
+			"vulns":[{"vuln":{"id":"CVE-2022-0778","cvss":7.5}}]}]}]}]`)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadScanSummary(ctx, "dev/app", "v1")
+	if err != nil {
+		t.Fatalf("expected (%v), got (%v)", nil, err)
+	}
+	if len(resp) != 1 || resp[0].Critical != 1 || resp[0].Major != 2 || resp[0].Minor != 3 {
+		t.Errorf("unexpected scan summary (%+v)", resp)
+	}
+	if got := resp[0].Layers[0].Components[0].Vulns[0].Vuln.ID; got != "CVE-2022-0778" {
+		t.Errorf("expected (%s), got (%s)", "CVE-2022-0778", got)
+	}
+}
+
+func TestReadScanSummaryNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.ReadScanSummary(ctx, "dev/app", "v1")
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("expected (%v), got (%v)", client.ErrNotFound, err)
+	}
+}
+
+func TestScanVulnSeverity(t *testing.T) {
+	for cvss, expected := range map[float64]string{
+		9.8: client.SeverityCritical,
+		7:   client.SeverityCritical,
+		6.9: client.SeverityMajor,
+		4:   client.SeverityMajor,
+		3.9: client.SeverityMinor,
+		0:   client.SeverityMinor,
+	} {
+		if got := (client.ScanVuln{CVSS: cvss}).Severity(); got != expected {
+			t.Errorf("CVSS %v: expected (%s), got (%s)", cvss, expected, got)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// LogLevels are the log levels MSR accepts
+var LogLevels = []string{"debug", "info", "warning", "error", "fatal"}
+
+// Settings struct, only the non nil fields are updated
+type Settings struct {
+	DTRHost                *string `json:"dtrHost,omitempty"`
+	WebTLSCert             *string `json:"webTLSCert,omitempty"`
+	WebTLSKey              *string `json:"webTLSKey,omitempty"`
+	WebTLSCA               *string `json:"webTLSCA,omitempty"`
+	ScanningEnabled        *bool   `json:"scanningEnabled,omitempty"`
+	ScanningSyncOnline     *bool   `json:"scanningSyncOnline,omitempty"`
+	CreateRepositoryOnPush *bool   `json:"createRepositoryOnPush,omitempty"`
+	LogLevel               *string `json:"logLevel,omitempty"`
+}
+
+// ResponseSettings struct, the TLS key is never returned by MSR
+type ResponseSettings struct {
+	DTRHost                string `json:"dtrHost"`
+	WebTLSCert             string `json:"webTLSCert"`
+	WebTLSCA               string `json:"webTLSCA"`
+	ScanningEnabled        bool   `json:"scanningEnabled"`
+	ScanningSyncOnline     bool   `json:"scanningSyncOnline"`
+	CreateRepositoryOnPush bool   `json:"createRepositoryOnPush"`
+	LogLevel               string `json:"logLevel"`
+}
+
+// ReadSettings retrieves the MSR admin settings
+func (c *Client) ReadSettings(ctx context.Context) (ResponseSettings, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("meta/settings"), nil)
+	if err != nil {
+		return ResponseSettings{}, fmt.Errorf("reading settings failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponseSettings{}, fmt.Errorf("reading settings failed. %w", err)
+	}
+
+	settings := ResponseSettings{}
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return ResponseSettings{}, fmt.Errorf("reading settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return settings, nil
+}
+
+// UpdateSettings updates the MSR admin settings, leaving the settings not passed untouched
+func (c *Client) UpdateSettings(ctx context.Context, settings Settings) (ResponseSettings, error) {
+	if (settings == Settings{}) {
+		return ResponseSettings{}, fmt.Errorf("updating settings failed. %w: %+v", ErrEmptyStruct, settings)
+	}
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return ResponseSettings{}, fmt.Errorf("updating settings failed. %w: %s", ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createMsrUrl("meta/settings"), bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseSettings{}, fmt.Errorf("updating settings failed. %w: %s", ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseSettings{}, fmt.Errorf("updating settings failed. %w", err)
+	}
+
+	resSettings := ResponseSettings{}
+	if err := json.Unmarshal(resBody, &resSettings); err != nil {
+		return ResponseSettings{}, fmt.Errorf("updating settings failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return resSettings, nil
+}
@@ -0,0 +1,71 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+func TestUpdateSettingsPartial(t *testing.T) {
+	resSettings := client.ResponseSettings{
+		DTRHost:         "msr.example.com",
+		ScanningEnabled: false,
+		LogLevel:        "info",
+	}
+	mResSettings, err := json.Marshal(resSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Error(err)
+		}
+		// Only the settings passed are sent, explicit false values included
+		expected := map[string]interface{}{"scanningEnabled": false}
+		if !reflect.DeepEqual(expected, sent) {
+			t.Errorf("expected body (%+v), got (%+v)", expected, sent)
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mResSettings); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	disabled := false
+	resp, err := testClient.UpdateSettings(ctx, client.Settings{ScanningEnabled: &disabled})
+	if !reflect.DeepEqual(resSettings, resp) {
+		t.Errorf("expected (%+v), got (%+v)", resSettings, resp)
+	}
+	if err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+}
+
+func TestUpdateSettingsEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be sent for empty settings")
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	_, err = testClient.UpdateSettings(ctx, client.Settings{})
+	if !errors.Is(err, client.ErrEmptyStruct) {
+		t.Errorf("expected (%v), got (%v)", client.ErrEmptyStruct, err)
+	}
+}
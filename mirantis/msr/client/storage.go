@@ -0,0 +1,168 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// FilesystemStorage is the local or mounted volume storage backend
+type FilesystemStorage struct {
+	RootDirectory string `json:"rootdirectory,omitempty"`
+}
+
+// S3Storage is the Amazon S3 (or compatible) storage backend
+type S3Storage struct {
+	Region         string `json:"region"`
+	Bucket         string `json:"bucket"`
+	AccessKey      string `json:"accesskey,omitempty"`
+	SecretKey      string `json:"secretkey,omitempty"`
+	RegionEndpoint string `json:"regionendpoint,omitempty"`
+	RootDirectory  string `json:"rootdirectory,omitempty"`
+	Encrypt        bool   `json:"encrypt"`
+	Secure         bool   `json:"secure"`
+	V4Auth         bool   `json:"v4auth"`
+}
+
+// AzureStorage is the Azure blob storage backend
+type AzureStorage struct {
+	AccountName string `json:"accountname"`
+	AccountKey  string `json:"accountkey,omitempty"`
+	Container   string `json:"container"`
+	Realm       string `json:"realm,omitempty"`
+}
+
+// SwiftStorage is the OpenStack Swift storage backend
+type SwiftStorage struct {
+	AuthURL            string `json:"authurl"`
+	Username           string `json:"username"`
+	Password           string `json:"password,omitempty"`
+	Container          string `json:"container"`
+	Region             string `json:"region,omitempty"`
+	Tenant             string `json:"tenant,omitempty"`
+	TenantID           string `json:"tenantid,omitempty"`
+	Domain             string `json:"domain,omitempty"`
+	Prefix             string `json:"prefix,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureskipverify"`
+}
+
+// NFSStorage is the NFS storage backend
+type NFSStorage struct {
+	URL string `json:"url"`
+}
+
+// StorageConfig is the registry storage configuration, exactly one backend has to be set
+type StorageConfig struct {
+	Filesystem *FilesystemStorage `json:"filesystem,omitempty"`
+	S3         *S3Storage         `json:"s3,omitempty"`
+	Azure      *AzureStorage      `json:"azure,omitempty"`
+	Swift      *SwiftStorage      `json:"swift,omitempty"`
+	NFS        *NFSStorage        `json:"nfs,omitempty"`
+}
+
+type storageSettings struct {
+	Storage StorageConfig `json:"storage"`
+}
+
+// Validate checks the storage config before it is submitted to MSR
+func (s StorageConfig) Validate() error {
+	backends := 0
+	missing := []string{}
+	require := func(backend string, fields map[string]string) {
+		for name, v := range fields {
+			if v == "" {
+				missing = append(missing, fmt.Sprintf("%s.%s", backend, name))
+			}
+		}
+	}
+
+	if s.Filesystem != nil {
+		backends++
+	}
+	if s.S3 != nil {
+		backends++
+		require("s3", map[string]string{"region": s.S3.Region, "bucket": s.S3.Bucket})
+		if (s.S3.AccessKey == "") != (s.S3.SecretKey == "") {
+			missing = append(missing, "s3.accesskey and s3.secretkey have to be set together")
+		}
+	}
+	if s.Azure != nil {
+		backends++
+		require("azure", map[string]string{
+			"accountname": s.Azure.AccountName,
+			"accountkey":  s.Azure.AccountKey,
+			"container":   s.Azure.Container,
+		})
+	}
+	if s.Swift != nil {
+		backends++
+		require("swift", map[string]string{
+			"authurl":   s.Swift.AuthURL,
+			"username":  s.Swift.Username,
+			"password":  s.Swift.Password,
+			"container": s.Swift.Container,
+		})
+	}
+	if s.NFS != nil {
+		backends++
+		require("nfs", map[string]string{"url": s.NFS.URL})
+		if s.NFS.URL != "" && !strings.HasPrefix(s.NFS.URL, "nfs://") {
+			missing = append(missing, "nfs.url has to start with nfs://")
+		}
+	}
+
+	if backends != 1 {
+		return fmt.Errorf("%w: exactly one storage backend has to be set, got %d", ErrInvalidStorageConfig, backends)
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%w: %s", ErrInvalidStorageConfig, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// ReadStorage retrieves the registry storage configuration, credentials are redacted by MSR
+func (c *Client) ReadStorage(ctx context.Context) (StorageConfig, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("admin/settings/registry/simple"), nil)
+	if err != nil {
+		return StorageConfig{}, fmt.Errorf("reading storage config failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return StorageConfig{}, fmt.Errorf("reading storage config failed. %w", err)
+	}
+
+	settings := storageSettings{}
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return StorageConfig{}, fmt.Errorf("reading storage config failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return settings.Storage, nil
+}
+
+// UpdateStorage validates and replaces the registry storage configuration
+func (c *Client) UpdateStorage(ctx context.Context, storage StorageConfig) error {
+	if err := storage.Validate(); err != nil {
+		return fmt.Errorf("updating storage config failed. %w", err)
+	}
+	body, err := json.Marshal(storageSettings{Storage: storage})
+	if err != nil {
+		return fmt.Errorf("updating storage config failed. %w: %s", ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.createMsrUrl("admin/settings/registry/simple"), bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("updating storage config failed. %w: %s", ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("updating storage config failed. %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+func TestStorageConfigValidate(t *testing.T) {
+	tcs := map[string]struct {
+		config      client.StorageConfig
+		expectedErr error
+	}{
+		"no backend": {
+			config:      client.StorageConfig{},
+			expectedErr: client.ErrInvalidStorageConfig,
+		},
+		"two backends": {
+			config: client.StorageConfig{
+				Filesystem: &client.FilesystemStorage{},
+				NFS:        &client.NFSStorage{URL: "nfs://nfs.example.com/msr"},
+			},
+			expectedErr: client.ErrInvalidStorageConfig,
+		},
+		"s3 without bucket": {
+			config:      client.StorageConfig{S3: &client.S3Storage{Region: "eu-west-1"}},
+			expectedErr: client.ErrInvalidStorageConfig,
+		},
+		"s3 with half credentials": {
+			config:      client.StorageConfig{S3: &client.S3Storage{Region: "eu-west-1", Bucket: "msr", AccessKey: "AKIA"}},
+			expectedErr: client.ErrInvalidStorageConfig,
+		},
+		"s3 with instance profile": {
+			config:      client.StorageConfig{S3: &client.S3Storage{Region: "eu-west-1", Bucket: "msr"}},
+			expectedErr: nil,
+		},
+		"nfs without scheme": {
+			config:      client.StorageConfig{NFS: &client.NFSStorage{URL: "nfs.example.com/msr"}},
+			expectedErr: client.ErrInvalidStorageConfig,
+		},
+		"filesystem": {
+			config:      client.StorageConfig{Filesystem: &client.FilesystemStorage{}},
+			expectedErr: nil,
+		},
+	}
+	for name, tc := range tcs {
+		if err := tc.config.Validate(); !errors.Is(err, tc.expectedErr) {
+			t.Errorf("%s: expected (%v), got (%v)", name, tc.expectedErr, err)
+		}
+	}
+}
+
+func TestUpdateStorageRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		if _, err := w.Write([]byte(`{"errors":[
+			{"code":"INVALID_SETTINGS","message":"bucket msr does not exist"},
+			{"code":"INVALID_SETTINGS","message":"access denied"}]}`)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	err = testClient.UpdateStorage(ctx, client.StorageConfig{S3: &client.S3Storage{Region: "eu-west-1", Bucket: "msr"}})
+	if !errors.Is(err, client.ErrResponseError) {
+		t.Errorf("expected (%v), got (%v)", client.ErrResponseError, err)
+	}
+	apiErr := &client.APIError{}
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) != 2 {
+		t.Errorf("expected every MSR error to be kept, got (%v)", err)
+	}
+}
+
+func TestUpdateStorageInvalid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be sent for an invalid config")
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	err = testClient.UpdateStorage(ctx, client.StorageConfig{})
+	if !errors.Is(err, client.ErrInvalidStorageConfig) {
+		t.Errorf("expected (%v), got (%v)", client.ErrInvalidStorageConfig, err)
+	}
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// VulnSummary is the scan summary attached to a tag or manifest
+type VulnSummary struct {
+	Critical         int       `json:"critical"`
+	Major            int       `json:"major"`
+	Minor            int       `json:"minor"`
+	LastScanStatus   int       `json:"last_scan_status"`
+	CheckCompletedAt time.Time `json:"check_completed_at"`
+	ShouldRescan     bool      `json:"should_rescan"`
+	HasForeignLayers bool      `json:"has_foreign_layers"`
+}
+
+// Manifest struct
+type Manifest struct {
+	Digest          string    `json:"digest"`
+	MediaType       string    `json:"mediaType"`
+	ConfigMediaType string    `json:"configMediaType"`
+	Size            int64     `json:"size"`
+	CreatedAt       time.Time `json:"createdAt"`
+	OS              string    `json:"os"`
+	OSVersion       string    `json:"osVersion"`
+	Architecture    string    `json:"architecture"`
+	Author          string    `json:"author"`
+}
+
+// ResponseTag struct
+type ResponseTag struct {
+	Name         string       `json:"name"`
+	Digest       string       `json:"digest"`
+	Author       string       `json:"author"`
+	Manifest     Manifest     `json:"manifest"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	UpdatedAt    time.Time    `json:"updatedAt"`
+	HashMismatch bool         `json:"hashMismatch"`
+	InNotary     bool         `json:"inNotary"`
+	VulnSummary  *VulnSummary `json:"vuln_summary,omitempty"`
+}
+
+// ResponseManifest struct
+type ResponseManifest struct {
+	Manifest
+	VulnSummary *VulnSummary `json:"vuln_summary,omitempty"`
+}
+
+// ReadRepoTags retrieves all the tags of a repo, following the MSR paging cursor
+func (c *Client) ReadRepoTags(ctx context.Context, repoName string) ([]ResponseTag, error) {
+	url := fmt.Sprintf("%s/%s/tags", c.createMsrUrl("repositories"), repoName)
+	tags := []ResponseTag{}
+	pageStart := ""
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return []ResponseTag{}, fmt.Errorf("reading tags of repo %s failed. %w: %s", repoName, ErrRequestCreation, err)
+		}
+		q := req.URL.Query()
+		q.Add("pageSize", strconv.Itoa(MSRPAGESIZE))
+		q.Add("pageStart", pageStart)
+		q.Add("includeManifests", "true")
+		req.URL.RawQuery = q.Encode()
+
+		body, header, err := c.doRequestWithHeader(req)
+		if err != nil {
+			return []ResponseTag{}, fmt.Errorf("reading tags of repo %s failed. %w", repoName, err)
+		}
+
+		page := []ResponseTag{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return []ResponseTag{}, fmt.Errorf("reading tags of repo %s failed. %w: %s", repoName, ErrUnmarshaling, err)
+		}
+		tags = append(tags, page...)
+
+		pageStart = header.Get(MSRNEXTPAGEHEADER)
+		if pageStart == "" || len(page) == 0 {
+			break
+		}
+	}
+
+	return tags, nil
+}
+
+// ReadRepoTag retrieves a single tag of a repo
+func (c *Client) ReadRepoTag(ctx context.Context, repoName string, tag string) (ResponseTag, error) {
+	url := fmt.Sprintf("%s/%s/tags/%s", c.createMsrUrl("repositories"), repoName, tag)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ResponseTag{}, fmt.Errorf("reading tag %s:%s failed. %w: %s", repoName, tag, ErrRequestCreation, err)
+	}
+	q := req.URL.Query()
+	q.Add("includeManifests", "true")
+	req.URL.RawQuery = q.Encode()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return ResponseTag{}, fmt.Errorf("reading tag %s:%s failed. %w", repoName, tag, err)
+	}
+
+	resTag := ResponseTag{}
+	if err := json.Unmarshal(body, &resTag); err != nil {
+		return ResponseTag{}, fmt.Errorf("reading tag %s:%s failed. %w: %s", repoName, tag, ErrUnmarshaling, err)
+	}
+
+	return resTag, nil
+}
+
+// ReadRepoManifests retrieves all the manifests of a repo, following the MSR paging cursor
+func (c *Client) ReadRepoManifests(ctx context.Context, repoName string) ([]ResponseManifest, error) {
+	url := fmt.Sprintf("%s/%s/manifests", c.createMsrUrl("repositories"), repoName)
+	manifests := []ResponseManifest{}
+	pageStart := ""
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return []ResponseManifest{}, fmt.Errorf("reading manifests of repo %s failed. %w: %s", repoName, ErrRequestCreation, err)
+		}
+		q := req.URL.Query()
+		q.Add("pageSize", strconv.Itoa(MSRPAGESIZE))
+		q.Add("pageStart", pageStart)
+		req.URL.RawQuery = q.Encode()
+
+		body, header, err := c.doRequestWithHeader(req)
+		if err != nil {
+			return []ResponseManifest{}, fmt.Errorf("reading manifests of repo %s failed. %w", repoName, err)
+		}
+
+		page := []ResponseManifest{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return []ResponseManifest{}, fmt.Errorf("reading manifests of repo %s failed. %w: %s", repoName, ErrUnmarshaling, err)
+		}
+		manifests = append(manifests, page...)
+
+		pageStart = header.Get(MSRNEXTPAGEHEADER)
+		if pageStart == "" || len(page) == 0 {
+			break
+		}
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,129 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testTagsStruct struct {
+	server           *httptest.Server
+	expectedResponse []client.ResponseTag
+	expectedErr      error
+}
+
+func TestReadRepoTagsPaged(t *testing.T) {
+	firstPage := []client.ResponseTag{{Name: "v1", Digest: "sha256:1"}}
+	secondPage := []client.ResponseTag{{Name: "v2", Digest: "sha256:2"}}
+	mFirst, err := json.Marshal(firstPage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mSecond, err := json.Marshal(secondPage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testTagsStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v0/repositories/fakeorg/fakerepo/tags" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			if r.URL.Query().Get("pageStart") == "" {
+				w.Header().Set(client.MSRNEXTPAGEHEADER, "v2")
+				w.WriteHeader(http.StatusOK)
+				if _, err := w.Write(mFirst); err != nil {
+					t.Error(err)
+				}
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mSecond); err != nil {
+				t.Error(err)
+			}
+		})),
+		expectedResponse: append(firstPage, secondPage...),
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadRepoTags(ctx, "fakeorg/fakerepo")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadRepoTagsFailed(t *testing.T) {
+	tc := testTagsStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(nil); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: []client.ResponseTag{},
+		expectedErr:      client.ErrUnmarshaling,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadRepoTags(ctx, "fakeorg/fakerepo")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadRepoTagSuccess(t *testing.T) {
+	resTag := client.ResponseTag{
+		Name:   "latest",
+		Digest: "sha256:abc",
+		Manifest: client.Manifest{
+			Digest:       "sha256:abc",
+			OS:           "linux",
+			Architecture: "amd64",
+		},
+	}
+	mResTag, err := json.Marshal(resTag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(mResTag); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadRepoTag(ctx, "fakeorg/fakerepo", "latest")
+	if !reflect.DeepEqual(resTag, resp) {
+		t.Errorf("expected (%+v), got (%+v)", resTag, resp)
+	}
+	if err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+}
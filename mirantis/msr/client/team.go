@@ -6,6 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 type Team struct {
@@ -16,12 +20,47 @@ type Team struct {
 	OrgID        string `json:"orgID"`
 }
 
-type teamUsers struct {
-	Members []struct {
-		IsAdmin bool `json:"isAdmin"`
-		// There is aditional fields available no present in Account
-		Member ResponseAccount `json:"member"`
-	} `json:"members"`
+// TeamMembersParallelism is the number of membership changes UpdateTeamUsers sends at once
+const TeamMembersParallelism = 5
+
+type teamsPage struct {
+	Teams         []Team `json:"teams"`
+	NextPageStart string `json:"nextPageStart"`
+}
+
+type teamMembersPage struct {
+	Members       []ResponseTeamMember `json:"members"`
+	NextPageStart string               `json:"nextPageStart"`
+}
+
+// TeamMembersError is returned when some of the team membership changes failed,
+// it names every failed user and matches ErrTeamMembers with errors.Is
+type TeamMembersError struct {
+	Failed map[string]error
+}
+
+func (e *TeamMembersError) Error() string {
+	users := make([]string, 0, len(e.Failed))
+	for u := range e.Failed {
+		users = append(users, u)
+	}
+	sort.Strings(users)
+	msgs := make([]string, 0, len(users))
+	for _, u := range users {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", u, e.Failed[u]))
+	}
+	return fmt.Sprintf("%s for %d user(s): %s", ErrTeamMembers, len(users), strings.Join(msgs, "; "))
+}
+
+func (e *TeamMembersError) Unwrap() error {
+	return ErrTeamMembers
+}
+
+// ResponseTeamMember struct
+type ResponseTeamMember struct {
+	IsAdmin  bool            `json:"isAdmin"`
+	IsPublic bool            `json:"isPublic"`
+	Member   ResponseAccount `json:"member"`
 }
 
 // CreateTeam creates a team in Enzin
@@ -72,6 +111,41 @@ func (c *Client) ReadTeam(ctx context.Context, orgID string, teamID string) (Tea
 	return team, nil
 }
 
+// ReadTeams retrieves all the teams of an org, page by page
+func (c *Client) ReadTeams(ctx context.Context, orgID string) ([]Team, error) {
+	endpoint := c.createEnziUrl(fmt.Sprintf("accounts/%s/teams", orgID))
+	teams := []Team{}
+	start := ""
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return []Team{}, fmt.Errorf("reading teams of org %s failed. %w: %s", orgID, ErrRequestCreation, err)
+		}
+		q := req.URL.Query()
+		q.Add("limit", strconv.Itoa(MSRPAGESIZE))
+		q.Add("start", start)
+		req.URL.RawQuery = q.Encode()
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return []Team{}, fmt.Errorf("reading teams of org %s failed. %w", orgID, err)
+		}
+
+		page := teamsPage{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return []Team{}, fmt.Errorf("reading teams of org %s failed. %w: %s", orgID, ErrUnmarshaling, err)
+		}
+		teams = append(teams, page.Teams...)
+
+		start = page.NextPageStart
+		if start == "" || len(page.Teams) == 0 {
+			break
+		}
+	}
+
+	return teams, nil
+}
+
 // UpdateTeam updates a team in the enzi endpoint
 func (c *Client) UpdateTeam(ctx context.Context, orgID string, team Team) (Team, error) {
 	url := fmt.Sprintf("%s/%s/teams/%s", c.createEnziUrl("accounts"), orgID, team.ID)
@@ -135,70 +209,137 @@ func (c *Client) AddUserToTeam(ctx context.Context, orgID string, teamID string,
 	return nil
 }
 
-// GetTeamUsers retrieves the users of a given team
-func (c *Client) GetTeamUsers(ctx context.Context, orgID string, teamID string) (teamUsers, error) {
+// GetTeamUsers retrieves all the members of a given team, page by page
+func (c *Client) GetTeamUsers(ctx context.Context, orgID string, teamID string) ([]ResponseTeamMember, error) {
 	endpoint := c.createEnziUrl(fmt.Sprintf("accounts/%s/teams/%s/members", orgID, teamID))
+	members := []ResponseTeamMember{}
+	start := ""
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return []ResponseTeamMember{}, fmt.Errorf("reading members of team %s failed. %w: %s", teamID, ErrRequestCreation, err)
+		}
+		q := req.URL.Query()
+		q.Add("limit", strconv.Itoa(MSRPAGESIZE))
+		q.Add("start", start)
+		req.URL.RawQuery = q.Encode()
+
+		resBody, err := c.doRequest(req)
+		if err != nil {
+			return []ResponseTeamMember{}, fmt.Errorf("reading members of team %s failed. %w", teamID, err)
+		}
+
+		page := teamMembersPage{}
+		if err := json.Unmarshal(resBody, &page); err != nil {
+			return []ResponseTeamMember{}, fmt.Errorf("reading members of team %s failed. %w: %s", teamID, ErrUnmarshaling, err)
+		}
+		members = append(members, page.Members...)
+
+		start = page.NextPageStart
+		if start == "" || len(page.Members) == 0 {
+			break
+		}
+	}
+
+	return members, nil
+}
+
+// ReadTeamMember retrieves the membership of a user in a team
+func (c *Client) ReadTeamMember(ctx context.Context, orgID string, teamID string, userID string) (ResponseTeamMember, error) {
+	endpoint := c.createEnziUrl(fmt.Sprintf("accounts/%s/teams/%s/members/%s", orgID, teamID, userID))
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
-		return teamUsers{}, fmt.Errorf("retrieving user of team failed in MSR client: %w", err)
+		return ResponseTeamMember{}, fmt.Errorf("reading member %s of team %s failed. %w: %s", userID, teamID, ErrRequestCreation, err)
 	}
 
-	resBody, err := c.doRequest(req)
-	fmt.Printf("Body %+v", string(resBody))
+	body, err := c.doRequest(req)
 	if err != nil {
-		return teamUsers{}, fmt.Errorf("retrieving user of team failed in MSR client: %w", err)
+		return ResponseTeamMember{}, fmt.Errorf("reading member %s of team %s failed. %w", userID, teamID, err)
 	}
 
-	tUsers := teamUsers{}
-	if err := json.Unmarshal(resBody, &tUsers); err != nil {
-		return teamUsers{}, fmt.Errorf("retrieving user of team failed in MSR client: %w", err)
-
+	member := ResponseTeamMember{}
+	if err := json.Unmarshal(body, &member); err != nil {
+		return ResponseTeamMember{}, fmt.Errorf("reading member %s of team %s failed. %w: %s", userID, teamID, ErrUnmarshaling, err)
 	}
 
-	return tUsers, nil
+	return member, nil
 }
 
 // DeleteUserFromTeam deletes a user from a given team
 func (c *Client) DeleteUserFromTeam(ctx context.Context, orgID string, teamID string, userID string) error {
-	// Check if the user exists -> then proceed to delete it
-	if _, err := c.ReadAccount(ctx, userID); err != nil {
-		return nil
-	}
 	endpoint := c.createEnziUrl(fmt.Sprintf("accounts/%s/teams/%s/members/%s", orgID, teamID, userID))
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("deleting user from team failed in MSR client: %w", err)
+		return fmt.Errorf("deleting member %s of team %s failed. %w: %s", userID, teamID, ErrRequestCreation, err)
 	}
 
 	if _, err := c.doRequest(req); err != nil {
-		return fmt.Errorf("deleting user from team failed in MSR client: %w", err)
+		return fmt.Errorf("deleting member %s of team %s failed. %w", userID, teamID, err)
 	}
 
 	return nil
 }
 
-// UpdateTeamUsers updates a team user base to match the latest state defined by Terraform
-func (c *Client) UpdateTeamUsers(ctx context.Context, orgID string, teamID string, newUsers []interface{}) error {
-
-	tUsers, err := c.GetTeamUsers(ctx, orgID, teamID)
+// UpdateTeamUsers updates a team user base to match the latest state defined by Terraform.
+// Only the missing users are added and the extra users removed, concurrently with at most
+// TeamMembersParallelism requests in flight. Every failed user is reported in a TeamMembersError
+func (c *Client) UpdateTeamUsers(ctx context.Context, orgID string, teamID string, userIDs []string) error {
+	members, err := c.GetTeamUsers(ctx, orgID, teamID)
 	if err != nil {
-		return fmt.Errorf("updating team users failed: %w", err)
+		return fmt.Errorf("updating members of team %s failed. %w", teamID, err)
 	}
-	for _, user := range tUsers.Members {
-		if err := c.DeleteUserFromTeam(ctx, orgID, teamID, user.Member.ID); err != nil {
-			return fmt.Errorf("updating team users failed: %w", err)
+
+	wanted := map[string]bool{}
+	for _, id := range userIDs {
+		wanted[id] = true
+	}
+	current := map[string]bool{}
+	remove := []string{}
+	for _, m := range members {
+		current[m.Member.ID] = true
+		current[m.Member.Name] = true
+		if !wanted[m.Member.ID] && !wanted[m.Member.Name] {
+			remove = append(remove, m.Member.ID)
 		}
 	}
-
-	for _, u := range newUsers {
-		a := ResponseAccount{
-			ID: u.(string),
+	add := []string{}
+	for _, id := range userIDs {
+		if !current[id] {
+			add = append(add, id)
 		}
-		// Log the failure in the future if you fail to add an user to a team
-		if err := c.AddUserToTeam(ctx, orgID, teamID, a); err != nil {
-			continue
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		sem    = make(chan struct{}, TeamMembersParallelism)
+		failed = map[string]error{}
+	)
+	apply := func(userID string, f func() error) {
+		defer wg.Done()
+		sem <- struct{}{}
+		defer func() { <-sem }()
+		if err := f(); err != nil {
+			mu.Lock()
+			failed[userID] = err
+			mu.Unlock()
 		}
 	}
+	for _, id := range remove {
+		id := id
+		wg.Add(1)
+		go apply(id, func() error { return c.DeleteUserFromTeam(ctx, orgID, teamID, id) })
+	}
+	for _, id := range add {
+		id := id
+		wg.Add(1)
+		go apply(id, func() error { return c.AddUserToTeam(ctx, orgID, teamID, ResponseAccount{ID: id}) })
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return fmt.Errorf("updating members of team %s failed. %w", teamID, &TeamMembersError{Failed: failed})
+	}
 
 	return nil
 }
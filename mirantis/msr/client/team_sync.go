@@ -0,0 +1,99 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// LDAPSyncAction is the enzi job action syncing the LDAP backed accounts and teams
+const LDAPSyncAction = "ldap-sync"
+
+// MemberSyncConfig struct, the enzi LDAP member sync configuration of a team.
+// Members are either taken from the GroupDN group or from the results of the
+// SearchFilter search under SearchBaseDN, as chosen by SelectGroupMembers
+type MemberSyncConfig struct {
+	EnableSync         bool   `json:"enableSync"`
+	SelectGroupMembers bool   `json:"selectGroupMembers"`
+	GroupDN            string `json:"groupDN"`
+	GroupMemberAttr    string `json:"groupMemberAttr"`
+	SearchBaseDN       string `json:"searchBaseDN"`
+	SearchFilter       string `json:"searchFilter"`
+	SearchScopeSubtree bool   `json:"searchScopeSubtree"`
+}
+
+// ReadTeamMemberSync retrieves the LDAP member sync configuration of a team
+func (c *Client) ReadTeamMemberSync(ctx context.Context, orgID string, teamID string) (MemberSyncConfig, error) {
+	url := c.createEnziUrl(fmt.Sprintf("accounts/%s/teams/%s/memberSyncConfig", orgID, teamID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("reading member sync config of team %s failed. %w: %s", teamID, ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("reading member sync config of team %s failed. %w", teamID, err)
+	}
+
+	cfg := MemberSyncConfig{}
+	if err := json.Unmarshal(body, &cfg); err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("reading member sync config of team %s failed. %w: %s", teamID, ErrUnmarshaling, err)
+	}
+
+	return cfg, nil
+}
+
+// UpdateTeamMemberSync replaces the LDAP member sync configuration of a team
+func (c *Client) UpdateTeamMemberSync(ctx context.Context, orgID string, teamID string, cfg MemberSyncConfig) (MemberSyncConfig, error) {
+	if cfg.EnableSync && cfg.GroupDN == "" && cfg.SearchBaseDN == "" {
+		return MemberSyncConfig{}, fmt.Errorf("updating member sync config of team %s failed. %w: neither group DN nor search base DN set", teamID, ErrEmptyStruct)
+	}
+	body, err := json.Marshal(cfg)
+	if err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("updating member sync config of team %s failed. %w: %s", teamID, ErrMarshaling, err)
+	}
+	url := c.createEnziUrl(fmt.Sprintf("accounts/%s/teams/%s/memberSyncConfig", orgID, teamID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(body))
+	if err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("updating member sync config of team %s failed. %w: %s", teamID, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("updating member sync config of team %s failed. %w", teamID, err)
+	}
+
+	if err := json.Unmarshal(resBody, &cfg); err != nil {
+		return MemberSyncConfig{}, fmt.Errorf("updating member sync config of team %s failed. %w: %s", teamID, ErrUnmarshaling, err)
+	}
+
+	return cfg, nil
+}
+
+// SyncLDAP triggers an on-demand enzi LDAP sync, updating the members of every team with sync enabled
+func (c *Client) SyncLDAP(ctx context.Context) (Job, error) {
+	body, err := json.Marshal(map[string]string{"action": LDAPSyncAction})
+	if err != nil {
+		return Job{}, fmt.Errorf("triggering LDAP sync failed. %w: %s", ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createEnziUrl("jobs"), bytes.NewBuffer(body))
+	if err != nil {
+		return Job{}, fmt.Errorf("triggering LDAP sync failed. %w: %s", ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return Job{}, fmt.Errorf("triggering LDAP sync failed. %w", err)
+	}
+
+	job := Job{}
+	if err := json.Unmarshal(resBody, &job); err != nil {
+		return Job{}, fmt.Errorf("triggering LDAP sync failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return job, nil
+}
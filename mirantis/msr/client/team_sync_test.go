@@ -0,0 +1,113 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testMemberSyncStruct struct {
+	server           *httptest.Server
+	expectedResponse client.MemberSyncConfig
+	expectedErr      error
+}
+
+func TestUpdateTeamMemberSyncSuccess(t *testing.T) {
+	cfg := client.MemberSyncConfig{
+		EnableSync:         true,
+		SelectGroupMembers: true,
+		GroupDN:            "cn=devs,ou=groups,dc=example,dc=org",
+		GroupMemberAttr:    "member",
+	}
+	tc := testMemberSyncStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/enzi/v0/accounts/org/teams/team/memberSyncConfig" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			got := client.MemberSyncConfig{}
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Error(err)
+			}
+			body, err := json.Marshal(got)
+			if err != nil {
+				t.Error(err)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(body); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: cfg,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateTeamMemberSync(ctx, "org", "team", cfg)
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestUpdateTeamMemberSyncNoSource(t *testing.T) {
+	tc := testMemberSyncStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("no request should be sent without a group or search base")
+		})),
+		expectedResponse: client.MemberSyncConfig{},
+		expectedErr:      client.ErrEmptyStruct,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.UpdateTeamMemberSync(ctx, "org", "team", client.MemberSyncConfig{EnableSync: true})
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestSyncLDAP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/enzi/v0/jobs" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		if _, err := w.Write([]byte(`{"id":"sync-1","action":"ldap-sync","status":"waiting"}`)); err != nil {
+			t.Error(err)
+			return
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	job, err := testClient.SyncLDAP(ctx)
+	if err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+	expected := client.Job{ID: "sync-1", Action: client.LDAPSyncAction, Status: client.JobWaiting}
+	if !reflect.DeepEqual(expected, job) {
+		t.Errorf("expected (%+v), got (%+v)", expected, job)
+	}
+}
@@ -0,0 +1,208 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testTeamMemberStruct struct {
+	server           *httptest.Server
+	expectedResponse client.ResponseTeamMember
+	expectedErr      error
+}
+
+func TestReadTeamMemberSuccess(t *testing.T) {
+	member := client.ResponseTeamMember{
+		IsAdmin: true,
+		Member:  client.ResponseAccount{Name: "jdoe", ID: "fake-user-id", IsActive: true},
+	}
+	mMember, err := json.Marshal(member)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testTeamMemberStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/enzi/v0/accounts/engineering/teams/devs/members/jdoe" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mMember); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: member,
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadTeamMember(ctx, "engineering", "devs", "jdoe")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadTeamMemberNotFound(t *testing.T) {
+	tc := testTeamMemberStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})),
+		expectedResponse: client.ResponseTeamMember{},
+		expectedErr:      client.ErrNotFound,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadTeamMember(ctx, "engineering", "devs", "jdoe")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+// teamMembersServer serves the members of a team over two pages and records the
+// membership changes, the changes of the users in failing are rejected
+func teamMembersServer(t *testing.T, failing map[string]bool, changes *sync.Map) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			page := map[string]interface{}{
+				"members": []client.ResponseTeamMember{
+					{Member: client.ResponseAccount{ID: "id-keep", Name: "keep"}},
+					{Member: client.ResponseAccount{ID: "id-drop", Name: "drop"}},
+				},
+				"nextPageStart": "id-stuck",
+			}
+			if r.URL.Query().Get("start") == "id-stuck" {
+				page = map[string]interface{}{
+					"members": []client.ResponseTeamMember{
+						{Member: client.ResponseAccount{ID: "id-stuck", Name: "stuck"}},
+					},
+				}
+			}
+			w.WriteHeader(http.StatusOK)
+			if err := json.NewEncoder(w).Encode(page); err != nil {
+				t.Error(err)
+			}
+			return
+		}
+		user := path.Base(r.URL.Path)
+		changes.Store(r.Method+" "+user, true)
+		if failing[user] {
+			w.WriteHeader(http.StatusBadRequest)
+			if _, err := w.Write([]byte(`{"errors":[{"code":"INVALID","message":"rejected"}]}`)); err != nil {
+				t.Error(err)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestUpdateTeamUsersOnlyAppliesDiff(t *testing.T) {
+	changes := &sync.Map{}
+	server := teamMembersServer(t, map[string]bool{}, changes)
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if err := testClient.UpdateTeamUsers(ctx, "org", "team", []string{"id-keep", "stuck", "id-new"}); err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+	got := []string{}
+	changes.Range(func(k, v interface{}) bool {
+		got = append(got, k.(string))
+		return true
+	})
+	sort.Strings(got)
+	expected := []string{"DELETE id-drop", "PUT id-new"}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected (%v), got (%v)", expected, got)
+	}
+}
+
+func TestUpdateTeamUsersReportsEveryFailure(t *testing.T) {
+	changes := &sync.Map{}
+	server := teamMembersServer(t, map[string]bool{"id-drop": true, "id-bad": true}, changes)
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	err = testClient.UpdateTeamUsers(ctx, "org", "team", []string{"id-keep", "id-stuck", "id-bad", "id-new"})
+	if !errors.Is(err, client.ErrTeamMembers) {
+		t.Fatalf("expected (%v), got (%v)", client.ErrTeamMembers, err)
+	}
+	var membersErr *client.TeamMembersError
+	if !errors.As(err, &membersErr) {
+		t.Fatalf("expected a TeamMembersError, got (%v)", err)
+	}
+	failed := []string{}
+	for u := range membersErr.Failed {
+		failed = append(failed, u)
+	}
+	sort.Strings(failed)
+	if expected := []string{"id-bad", "id-drop"}; !reflect.DeepEqual(expected, failed) {
+		t.Errorf("expected (%v), got (%v)", expected, failed)
+	}
+	if _, ok := changes.Load("PUT id-new"); !ok {
+		t.Error("expected id-new to be added despite the other failures")
+	}
+}
+
+func TestReadTeamsPaginated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/enzi/v0/accounts/engineering/teams" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body := `{"teams":[{"id":"id-devs","name":"devs","membersCount":3}],"nextPageStart":"id-ops"}`
+		if r.URL.Query().Get("start") == "id-ops" {
+			body = `{"teams":[{"id":"id-ops","name":"ops","description":"on call"}]}`
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	teams, err := testClient.ReadTeams(ctx, "engineering")
+	if err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+	expected := []client.Team{
+		{ID: "id-devs", Name: "devs", MembersCount: 3},
+		{ID: "id-ops", Name: "ops", Description: "on call"},
+	}
+	if !reflect.DeepEqual(expected, teams) {
+		t.Errorf("expected (%+v), got (%+v)", expected, teams)
+	}
+}
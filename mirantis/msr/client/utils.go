@@ -1,17 +1,137 @@
 package client
 
 import (
-	"math/rand"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	mathrand "math/rand"
 	"time"
 )
 
-// GeneratePass creates a random password
+// Character classes passwords are generated from
+const (
+	PasswordLower   = "abcdefghijklmnopqrstuvwxyz"
+	PasswordUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	PasswordDigits  = "0123456789"
+	PasswordSpecial = "!@#$%^&*()-_=+"
+
+	// MinPasswordLength is the shortest password enzi accepts
+	MinPasswordLength = 8
+)
+
+// PasswordPolicy describes a generated password, every enabled
+// character class is guaranteed to appear at least once
+type PasswordPolicy struct {
+	Length  int
+	Lower   bool
+	Upper   bool
+	Digits  bool
+	Special bool
+}
+
+// DefaultPasswordPolicy is used for users created without a password
+var DefaultPasswordPolicy = PasswordPolicy{
+	Length:  16,
+	Lower:   true,
+	Upper:   true,
+	Digits:  true,
+	Special: true,
+}
+
+func (p PasswordPolicy) classes() []string {
+	classes := []string{}
+	if p.Lower {
+		classes = append(classes, PasswordLower)
+	}
+	if p.Upper {
+		classes = append(classes, PasswordUpper)
+	}
+	if p.Digits {
+		classes = append(classes, PasswordDigits)
+	}
+	if p.Special {
+		classes = append(classes, PasswordSpecial)
+	}
+	return classes
+}
+
+// Validate checks that passwords can be generated with the policy
+func (p PasswordPolicy) Validate() error {
+	classes := p.classes()
+	if len(classes) == 0 {
+		return fmt.Errorf("%w: no character class enabled", ErrInvalidPasswordPolicy)
+	}
+	// Every class needs a character of its own, on top of the length enzi requires
+	minLength := MinPasswordLength
+	if len(classes) > minLength {
+		minLength = len(classes)
+	}
+	if p.Length < minLength {
+		return fmt.Errorf("%w: length %d is shorter than %d", ErrInvalidPasswordPolicy, p.Length, minLength)
+	}
+	return nil
+}
+
+// randomIndex returns a uniformly distributed random number in [0, n)
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(i.Int64()), nil
+}
+
+// GeneratePassword creates a random password following the policy, using crypto/rand
+func GeneratePassword(p PasswordPolicy) (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+	classes := p.classes()
+	all := ""
+	for _, cl := range classes {
+		all += cl
+	}
+
+	b := make([]byte, p.Length)
+	for i := range b {
+		// The first characters cover every class, the rest is drawn from all of them
+		set := all
+		if i < len(classes) {
+			set = classes[i]
+		}
+		j, err := randomIndex(len(set))
+		if err != nil {
+			return "", fmt.Errorf("generating password failed. %s", err)
+		}
+		b[i] = set[j]
+	}
+	// Shuffle so the guaranteed characters aren't always in front
+	for i := len(b) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", fmt.Errorf("generating password failed. %s", err)
+		}
+		b[i], b[j] = b[j], b[i]
+	}
+
+	return string(b), nil
+}
+
+// GeneratePass creates a random password following the DefaultPasswordPolicy
+//
+// Deprecated: use GeneratePassword, which reports errors instead of hiding them.
 func GeneratePass() string {
+	pass, err := GeneratePassword(DefaultPasswordPolicy)
+	if err == nil {
+		return pass
+	}
+	// The default policy is valid, so only crypto/rand can fail. GeneratePass never
+	// failed before, so it keeps generating the password the way it used to.
 	var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ123456789!@#$")
-	rand.Seed(time.Now().UnixNano())
-	b := make([]rune, 8)
+	r := mathrand.New(mathrand.NewSource(time.Now().UnixNano()))
+	b := make([]rune, DefaultPasswordPolicy.Length)
 	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
+		b[i] = letters[r.Intn(len(letters))]
 	}
 	return string(b)
 }
@@ -0,0 +1,52 @@
+package client_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+func TestGeneratePasswordPolicy(t *testing.T) {
+	policy := client.PasswordPolicy{Length: 12, Upper: true, Digits: true}
+	seen := map[string]bool{}
+	for i := 0; i < 50; i++ {
+		pass, err := client.GeneratePassword(policy)
+		if err != nil {
+			t.Fatalf("expected (%v), got (%v)", nil, err)
+		}
+		if len(pass) != policy.Length {
+			t.Errorf("expected length (%d), got (%d)", policy.Length, len(pass))
+		}
+		if !strings.ContainsAny(pass, client.PasswordUpper) || !strings.ContainsAny(pass, client.PasswordDigits) {
+			t.Errorf("expected every enabled class in (%s)", pass)
+		}
+		if strings.ContainsAny(pass, client.PasswordLower+client.PasswordSpecial) {
+			t.Errorf("expected only enabled classes in (%s)", pass)
+		}
+		seen[pass] = true
+	}
+	if len(seen) < 50 {
+		t.Errorf("expected unique passwords, got %d out of 50", len(seen))
+	}
+}
+
+func TestGeneratePasswordInvalidPolicy(t *testing.T) {
+	policies := []client.PasswordPolicy{
+		{Length: 16},
+		{Length: client.MinPasswordLength - 1, Lower: true},
+	}
+	for _, p := range policies {
+		if _, err := client.GeneratePassword(p); !errors.Is(err, client.ErrInvalidPasswordPolicy) {
+			t.Errorf("expected (%v), got (%v)", client.ErrInvalidPasswordPolicy, err)
+		}
+	}
+}
+
+func TestGeneratePassDefaultPolicy(t *testing.T) {
+	pass := client.GeneratePass()
+	if len(pass) != client.DefaultPasswordPolicy.Length {
+		t.Errorf("expected length (%d), got (%d)", client.DefaultPasswordPolicy.Length, len(pass))
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MinSupportedVersion is MSR 2.8.0, the first release shipped as Mirantis Secure Registry.
+// Every endpoint the client uses was introduced by a Docker Trusted Registry
+// release and is part of it, so it is the minimum version of all the features
+var MinSupportedVersion = Version{Major: 2, Minor: 8}
+
+// Version is a parsed MSR semantic version
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseVersion parses versions of the form "2.9.1", "v3.0.0" or "3.0.0-tp1"
+func ParseVersion(s string) (Version, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("%w: '%s'", ErrInvalidVersion, s)
+	}
+	nums := [3]int{}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("%w: '%s'", ErrInvalidVersion, s)
+		}
+		nums[i] = n
+	}
+
+	return Version{Major: nums[0], Minor: nums[1], Patch: nums[2]}, nil
+}
+
+// AtLeast reports whether v is the same or a newer version than min
+func (v Version) AtLeast(min Version) bool {
+	if v.Major != min.Major {
+		return v.Major > min.Major
+	}
+	if v.Minor != min.Minor {
+		return v.Minor > min.Minor
+	}
+	return v.Patch >= min.Patch
+}
+
+// IsKnown reports whether v holds an actual version rather than the zero value
+func (v Version) IsKnown() bool {
+	return v != Version{}
+}
+
+// Supports reports whether the MSR instance is at least min. An unknown
+// version isn't gated, MSR rejecting the request on its own then
+func (c *Client) Supports(min Version) bool {
+	return !c.Version.IsKnown() || c.Version.AtLeast(min)
+}
+
+// LoadVersion fetches and parses the MSR version once and stores it on the client
+func (c *Client) LoadVersion(ctx context.Context) (Version, error) {
+	raw, err := c.GetMSRVersion(ctx)
+	if err != nil {
+		return Version{}, err
+	}
+	v, err := ParseVersion(raw)
+	if err != nil {
+		return Version{}, fmt.Errorf("getting MSR version failed. %w", err)
+	}
+	c.Version = v
+
+	return v, nil
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
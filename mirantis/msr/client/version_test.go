@@ -0,0 +1,118 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+// mustParseVersion is client.ParseVersion for version literals known to be valid
+func mustParseVersion(t *testing.T, s string) client.Version {
+	t.Helper()
+	v, err := client.ParseVersion(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestParseVersion(t *testing.T) {
+	tcs := []struct {
+		in          string
+		expected    client.Version
+		expectedErr error
+	}{
+		{in: "2.9.1", expected: client.Version{Major: 2, Minor: 9, Patch: 1}},
+		{in: "v3.0.0", expected: client.Version{Major: 3}},
+		{in: "3.1.0-tp1", expected: client.Version{Major: 3, Minor: 1}},
+		{in: "2.9", expected: client.Version{Major: 2, Minor: 9}},
+		{in: "latest", expectedErr: client.ErrInvalidVersion},
+		{in: "", expectedErr: client.ErrInvalidVersion},
+	}
+	for _, tc := range tcs {
+		v, err := client.ParseVersion(tc.in)
+		if !reflect.DeepEqual(tc.expected, v) {
+			t.Errorf("%s: expected (%+v), got (%+v)", tc.in, tc.expected, v)
+		}
+		if !errors.Is(err, tc.expectedErr) {
+			t.Errorf("%s: expected (%v), got (%v)", tc.in, tc.expectedErr, err)
+		}
+	}
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	min := mustParseVersion(t, "2.9.0")
+	for v, expected := range map[string]bool{
+		"2.8.9":  false,
+		"2.9.0":  true,
+		"2.9.10": true,
+		"3.0.0":  true,
+		"1.10.0": false,
+	} {
+		if got := mustParseVersion(t, v).AtLeast(min); got != expected {
+			t.Errorf("%s >= %s: expected (%v), got (%v)", v, min, expected, got)
+		}
+	}
+}
+
+func TestLoadVersion(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"version":"2.7.4"}`)); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	if !testClient.Supports(client.MinSupportedVersion) {
+		t.Error("expected an unknown version not to be gated")
+	}
+	ctx := context.Background()
+	v, err := testClient.LoadVersion(ctx)
+	if err != nil {
+		t.Fatalf("expected (%v), got (%v)", nil, err)
+	}
+	if expected := mustParseVersion(t, "2.7.4"); v != expected || testClient.Version != expected {
+		t.Errorf("expected (%s), got (%s) stored as (%s)", expected, v, testClient.Version)
+	}
+	if testClient.Supports(client.MinSupportedVersion) {
+		t.Errorf("expected %s not to support %s", v, client.MinSupportedVersion)
+	}
+	if min := mustParseVersion(t, "2.7.0"); !testClient.Supports(min) {
+		t.Errorf("expected %s to support %s", v, min)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single version request, got (%d)", calls)
+	}
+}
+
+func TestLoadVersionInvalid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"version":"latest"}`)); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if _, err := testClient.LoadVersion(ctx); !errors.Is(err, client.ErrInvalidVersion) {
+		t.Errorf("expected (%v), got (%v)", client.ErrInvalidVersion, err)
+	}
+	if testClient.Version.IsKnown() {
+		t.Errorf("expected the version to stay unknown, got (%s)", testClient.Version)
+	}
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// VulnDBUpdateAction is the action of the job updating the vulnerability database
+const VulnDBUpdateAction = "update_vuln_db"
+
+// UploadVulnDB streams an offline vulnerability database tarball to MSR and returns
+// the job importing it. The tarball is never held in memory as a whole, and db is no
+// longer read from once UploadVulnDB returns.
+func (c *Client) UploadVulnDB(ctx context.Context, filename string, db io.Reader) (Job, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		part, err := mw.CreateFormFile("upload", filename)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if _, err := io.Copy(part, db); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.CloseWithError(mw.Close())
+	}()
+
+	url := fmt.Sprintf("%s?online=false", c.createMsrUrl("imagescan/database"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, pr)
+	if err != nil {
+		pr.Close()
+		<-done
+		return Job{}, fmt.Errorf("uploading vulnerability database %s failed. %w: %s", filename, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	body, err := c.doRequest(req)
+	// Unblocks the writer if the request failed before the whole body was sent
+	pr.Close()
+	<-done
+	if err != nil {
+		return Job{}, fmt.Errorf("uploading vulnerability database %s failed. %w", filename, err)
+	}
+
+	job := Job{}
+	if err := json.Unmarshal(body, &job); err != nil {
+		return Job{}, fmt.Errorf("uploading vulnerability database %s failed. %w: %s", filename, ErrUnmarshaling, err)
+	}
+
+	return job, nil
+}
@@ -0,0 +1,51 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+func TestUploadVulnDBMultipart(t *testing.T) {
+	content := strings.Repeat("cve", 1<<16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v0/imagescan/database" || r.URL.Query().Get("online") != "false" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		file, header, err := r.FormFile("upload")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer file.Close()
+		got, err := ioutil.ReadAll(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if header.Filename != "cve.tar" || string(got) != content {
+			t.Errorf("unexpected upload %s of %d bytes", header.Filename, len(got))
+		}
+		w.WriteHeader(http.StatusAccepted)
+		if err := json.NewEncoder(w).Encode(client.Job{ID: "fake-job-id", Action: client.VulnDBUpdateAction, Status: client.JobWaiting}); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+	testClient, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	job, err := testClient.UploadVulnDB(ctx, "cve.tar", strings.NewReader(content))
+	if err != nil {
+		t.Errorf("expected (%v), got (%v)", nil, err)
+	}
+	if job.ID != "fake-job-id" {
+		t.Errorf("expected (%s), got (%s)", "fake-job-id", job.ID)
+	}
+}
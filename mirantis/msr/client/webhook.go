@@ -0,0 +1,209 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookTypes are the events MSR can notify a webhook of
+var WebhookTypes = []string{
+	"TAG_PUSH",
+	"TAG_DELETE",
+	"MANIFEST_PUSH",
+	"MANIFEST_DELETE",
+	"SCAN_COMPLETED",
+	"SCAN_FAILED",
+	"PROMOTION",
+	"PUSH_MIRRORING",
+	"POLL_MIRRORING",
+	"REPO_EVENT",
+	"REPO_DELETED",
+	"TAG_PRUNED",
+}
+
+// Webhook struct
+type Webhook struct {
+	Type                string `json:"type"`
+	Key                 string `json:"key"`
+	Endpoint            string `json:"endpoint"`
+	Authorization       string `json:"authorization,omitempty"`
+	TLSCert             string `json:"tlsCert,omitempty"`
+	ClientCert          string `json:"clientCert,omitempty"`
+	ClientKey           string `json:"clientKey,omitempty"`
+	SkipTLSVerification bool   `json:"skipTLSVerification"`
+	Inactive            bool   `json:"inactive"`
+}
+
+// UpdateWebhook struct, the credentials are always sent so that an empty value clears them
+type UpdateWebhook struct {
+	Endpoint            string `json:"endpoint,omitempty"`
+	Authorization       string `json:"authorization"`
+	TLSCert             string `json:"tlsCert"`
+	ClientCert          string `json:"clientCert"`
+	ClientKey           string `json:"clientKey"`
+	SkipTLSVerification bool   `json:"skipTLSVerification"`
+	Inactive            bool   `json:"inactive"`
+}
+
+// ResponseWebhook struct
+type ResponseWebhook struct {
+	ID                  string    `json:"id"`
+	Type                string    `json:"type"`
+	Key                 string    `json:"key"`
+	Endpoint            string    `json:"endpoint"`
+	TLSCert             string    `json:"tlsCert"`
+	ClientCert          string    `json:"clientCert"`
+	SkipTLSVerification bool      `json:"skipTLSVerification"`
+	Inactive            bool      `json:"inactive"`
+	CreatedAt           time.Time `json:"createdAt"`
+}
+
+// TestWebhook struct
+type TestWebhook struct {
+	Type          string `json:"type"`
+	Endpoint      string `json:"endpoint"`
+	Authorization string `json:"authorization,omitempty"`
+	TLSCert       string `json:"tlsCert,omitempty"`
+	ClientCert    string `json:"clientCert,omitempty"`
+	ClientKey     string `json:"clientKey,omitempty"`
+}
+
+// CreateWebhook creates a webhook in MSR
+func (c *Client) CreateWebhook(ctx context.Context, hook Webhook) (ResponseWebhook, error) {
+	if (hook == Webhook{}) {
+		return ResponseWebhook{}, fmt.Errorf("creating webhook failed. %w: %+v", ErrEmptyStruct, hook)
+	}
+	body, err := json.Marshal(hook)
+	if err != nil {
+		return ResponseWebhook{}, fmt.Errorf("creating %s webhook for %s failed. %w: %s", hook.Type, hook.Key, ErrMarshaling, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.createMsrUrl("webhooks"), bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseWebhook{}, fmt.Errorf("creating %s webhook for %s failed. %w: %s", hook.Type, hook.Key, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseWebhook{}, fmt.Errorf("creating %s webhook for %s failed. %w", hook.Type, hook.Key, err)
+	}
+
+	resHook := ResponseWebhook{}
+	if err := json.Unmarshal(resBody, &resHook); err != nil {
+		return ResponseWebhook{}, fmt.Errorf("creating %s webhook for %s failed. %w: %s", hook.Type, hook.Key, ErrUnmarshaling, err)
+	}
+
+	return resHook, nil
+}
+
+// ReadWebhooks retrieves all the webhooks of MSR
+func (c *Client) ReadWebhooks(ctx context.Context) ([]ResponseWebhook, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.createMsrUrl("webhooks"), nil)
+	if err != nil {
+		return []ResponseWebhook{}, fmt.Errorf("reading webhooks failed. %w: %s", ErrRequestCreation, err)
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return []ResponseWebhook{}, fmt.Errorf("reading webhooks failed. %w", err)
+	}
+
+	hooks := []ResponseWebhook{}
+	if err := json.Unmarshal(body, &hooks); err != nil {
+		return []ResponseWebhook{}, fmt.Errorf("reading webhooks failed. %w: %s", ErrUnmarshaling, err)
+	}
+
+	return hooks, nil
+}
+
+// ReadWebhook retrieves a single webhook, MSR has no endpoint for it so it is looked up in the full list
+func (c *Client) ReadWebhook(ctx context.Context, id string) (ResponseWebhook, error) {
+	hooks, err := c.ReadWebhooks(ctx)
+	if err != nil {
+		return ResponseWebhook{}, fmt.Errorf("reading webhook %s failed. %w", id, err)
+	}
+	for _, h := range hooks {
+		if h.ID == id {
+			return h, nil
+		}
+	}
+
+	return ResponseWebhook{}, fmt.Errorf("reading webhook %s failed. %w", id, ErrNotFound)
+}
+
+// UpdateWebhook updates a webhook in MSR
+func (c *Client) UpdateWebhook(ctx context.Context, id string, hook UpdateWebhook) (ResponseWebhook, error) {
+	if (hook == UpdateWebhook{}) {
+		return ResponseWebhook{}, fmt.Errorf("updating webhook %s failed. %w: %+v", id, ErrEmptyStruct, hook)
+	}
+	body, err := json.Marshal(hook)
+	if err != nil {
+		return ResponseWebhook{}, fmt.Errorf("updating webhook %s failed. %w: %s", id, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("webhooks"), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, bytes.NewBuffer(body))
+	if err != nil {
+		return ResponseWebhook{}, fmt.Errorf("updating webhook %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return ResponseWebhook{}, fmt.Errorf("updating webhook %s failed. %w", id, err)
+	}
+
+	resHook := ResponseWebhook{}
+	if err := json.Unmarshal(resBody, &resHook); err != nil {
+		return ResponseWebhook{}, fmt.Errorf("updating webhook %s failed. %w: %s", id, ErrUnmarshaling, err)
+	}
+
+	return resHook, nil
+}
+
+// DeleteWebhook deletes a webhook from MSR
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/%s", c.createMsrUrl("webhooks"), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting webhook %s failed. %w: %s", id, ErrRequestCreation, err)
+	}
+
+	if _, err := c.doRequest(req); err != nil {
+		return fmt.Errorf("deleting webhook %s failed. %w", id, err)
+	}
+
+	return nil
+}
+
+// TestWebhook makes MSR send a sample event of the given type to an endpoint,
+// an undelivered event is reported with ErrWebhookDelivery
+func (c *Client) TestWebhook(ctx context.Context, hook TestWebhook) error {
+	if hook.Type == "" || hook.Endpoint == "" {
+		return fmt.Errorf("testing webhook failed. %w: %+v", ErrEmptyStruct, hook)
+	}
+	body, err := json.Marshal(hook)
+	if err != nil {
+		return fmt.Errorf("testing %s webhook %s failed. %w: %s", hook.Type, hook.Endpoint, ErrMarshaling, err)
+	}
+	url := fmt.Sprintf("%s/test", c.createMsrUrl("webhooks"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("testing %s webhook %s failed. %w: %s", hook.Type, hook.Endpoint, ErrRequestCreation, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := c.doRequest(req); err != nil {
+		// MSR answers a bad request when the endpoint couldn't be delivered to
+		apiErr := &APIError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+			return fmt.Errorf("testing %s webhook %s failed. %w: %s", hook.Type, hook.Endpoint, ErrWebhookDelivery, apiErr)
+		}
+		return fmt.Errorf("testing %s webhook %s failed. %w", hook.Type, hook.Endpoint, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+type testWebhookStruct struct {
+	server           *httptest.Server
+	expectedResponse client.ResponseWebhook
+	expectedErr      error
+}
+
+func TestReadWebhookSuccess(t *testing.T) {
+	hooks := []client.ResponseWebhook{
+		{ID: "hook-1", Type: "TAG_PUSH", Key: "dev/app", Endpoint: "https://ci.example.com/push"},
+		{ID: "hook-2", Type: "SCAN_COMPLETED", Key: "dev/app", Endpoint: "https://ci.example.com/scan"},
+	}
+	mHooks, err := json.Marshal(hooks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc := testWebhookStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(mHooks); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: hooks[1],
+		expectedErr:      nil,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadWebhook(ctx, "hook-2")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestReadWebhookNotFound(t *testing.T) {
+	tc := testWebhookStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(`[]`)); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedResponse: client.ResponseWebhook{},
+		expectedErr:      client.ErrNotFound,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.ReadWebhook(ctx, "hook-1")
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestCreateWebhookEmpty(t *testing.T) {
+	tc := testWebhookStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("no request should be sent for an empty webhook")
+		})),
+		expectedResponse: client.ResponseWebhook{},
+		expectedErr:      client.ErrEmptyStruct,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	resp, err := testClient.CreateWebhook(ctx, client.Webhook{})
+	if !reflect.DeepEqual(tc.expectedResponse, resp) {
+		t.Errorf("expected (%+v), got (%+v)", tc.expectedResponse, resp)
+	}
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestTestWebhookFailed(t *testing.T) {
+	tc := testWebhookStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v0/webhooks/test" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			if _, err := w.Write([]byte(`{"errors":[{"code":"WEBHOOK_FAILED","message":"connection refused"}]}`)); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+		expectedErr: client.ErrWebhookDelivery,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	err = testClient.TestWebhook(ctx, client.TestWebhook{Type: "TAG_PUSH", Endpoint: "https://ci.example.com/push"})
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+}
+
+func TestTestWebhookUnauthorized(t *testing.T) {
+	tc := testWebhookStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})),
+		expectedErr: client.ErrUnauthorizedReq,
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	err = testClient.TestWebhook(ctx, client.TestWebhook{Type: "TAG_PUSH", Endpoint: "https://ci.example.com/push"})
+	if !errors.Is(err, tc.expectedErr) {
+		t.Errorf("expected (%v), got (%v)", tc.expectedErr, err)
+	}
+	if errors.Is(err, client.ErrWebhookDelivery) {
+		t.Errorf("unauthorized request reported as a failed delivery: %v", err)
+	}
+}
+
+func TestUpdateWebhookClearsCredentials(t *testing.T) {
+	tc := testWebhookStruct{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body := map[string]interface{}{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+				return
+			}
+			for _, k := range []string{"authorization", "tlsCert", "clientCert", "clientKey"} {
+				if v, ok := body[k]; !ok || v != "" {
+					t.Errorf("expected empty %s to be sent, got (%v)", k, v)
+				}
+			}
+			if _, err := w.Write([]byte(`{"id":"hook-id","endpoint":"https://ci.example.com/push"}`)); err != nil {
+				t.Error(err)
+				return
+			}
+		})),
+	}
+	defer tc.server.Close()
+	testClient, err := client.NewDefaultClient(tc.server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Error("couldn't create test client")
+	}
+	ctx := context.Background()
+	if _, err := testClient.UpdateWebhook(ctx, "hook-id", client.UpdateWebhook{Endpoint: "https://ci.example.com/push"}); err != nil {
+		t.Error(err)
+	}
+}
@@ -2,8 +2,6 @@ package connect
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -132,7 +130,7 @@ func dataSourceAccountRead(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(rAccount.ID)
 
 	return diag.Diagnostics{}
 }
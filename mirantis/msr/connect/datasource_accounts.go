@@ -2,13 +2,16 @@ package connect
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"regexp"
 	"strconv"
-	"time"
+	"strings"
 
 	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // DataSourceAccounts for retrieving MSR accounts in bulk
@@ -17,8 +20,26 @@ func dataSourceAccounts() *schema.Resource {
 		ReadContext: dataSourceAccountsRead,
 		Schema: map[string]*schema.Schema{
 			"filter": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(client.All),
+				ValidateFunc: validation.StringInSlice(client.AccountFilters, false),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return accounts whose name matches this regular expression.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return accounts whose name starts with this prefix.",
+			},
+			"is_imported": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return accounts imported from LDAP when true, or only local accounts when false.",
 			},
 			"accounts": {
 				Type:     schema.TypeList,
@@ -49,6 +70,10 @@ func dataSourceAccounts() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"is_imported": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						"members_count": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -64,22 +89,59 @@ func dataSourceAccounts() *schema.Resource {
 	}
 }
 
+// accountsQuery holds the filters of the accounts data source applied on the client side
+type accountsQuery struct {
+	filter     string
+	nameRegex  *regexp.Regexp
+	namePrefix string
+	// isImported is nil when imported and local accounts are both wanted
+	isImported *bool
+}
+
+// accountsQueryFromResourceData reads the filters, the regex being validated by the schema
+func accountsQueryFromResourceData(d *schema.ResourceData) accountsQuery {
+	q := accountsQuery{
+		filter:     d.Get("filter").(string),
+		nameRegex:  regexp.MustCompile(d.Get("name_regex").(string)),
+		namePrefix: d.Get("name_prefix").(string),
+	}
+	// false has to be told apart from unset
+	if isConfigured(d, "is_imported") {
+		imported := d.Get("is_imported").(bool)
+		q.isImported = &imported
+	}
+	return q
+}
+
+// matches tells whether an account passes the name and LDAP filters
+func (q accountsQuery) matches(a client.ResponseAccount) bool {
+	if !strings.HasPrefix(a.Name, q.namePrefix) || !q.nameRegex.MatchString(a.Name) {
+		return false
+	}
+	return q.isImported == nil || a.IsImported == *q.isImported
+}
+
+// id identifies the query, so the same filters always give the same ID
+func (q accountsQuery) id() string {
+	query := []string{q.filter, q.namePrefix, q.nameRegex.String()}
+	if q.isImported != nil {
+		query = append(query, strconv.FormatBool(*q.isImported))
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(query, "\x00"))))
+}
+
 func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c, ok := m.(client.Client)
 	if !ok {
 		return diag.Errorf("unable to cast meta interface to MSR Client")
 	}
 
-	filter := client.AccountFilter("all")
-	if _, ok := d.GetOk("filter"); ok {
-		inputFilter := d.Get("filter").(string)
-		filter = client.AccountFilter(inputFilter)
-
-		if filter.APIFormOfFilter() != inputFilter {
-			d.SetId("")
-			return diag.FromErr(fmt.Errorf("%w. Filter '%s'", client.ErrInvalidFilter, inputFilter))
-		}
+	q := accountsQueryFromResourceData(d)
+	filter := client.AccountFilter(q.filter)
+	if filter.APIFormOfFilter() != q.filter {
+		return diag.FromErr(fmt.Errorf("%w. Filter '%s'", client.ErrInvalidFilter, q.filter))
 	}
+
 	rAccounts, err := c.ReadAccounts(ctx, filter)
 	if err != nil {
 		// If the accounts doesn't exist we should gracefully handle it
@@ -90,6 +152,9 @@ func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, m inter
 	accounts := make([]map[string]interface{}, 0, len(rAccounts))
 
 	for _, u := range rAccounts {
+		if !q.matches(u) {
+			continue
+		}
 		accounts = append(accounts, map[string]interface{}{
 			"id":            u.ID,
 			"name":          u.Name,
@@ -97,6 +162,7 @@ func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, m inter
 			"is_active":     u.IsActive,
 			"is_admin":      u.IsAdmin,
 			"is_org":        u.IsOrg,
+			"is_imported":   u.IsImported,
 			"members_count": u.MembersCount,
 			"teams_count":   u.TeamsCount,
 		})
@@ -105,8 +171,7 @@ func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, m inter
 	if err := d.Set("accounts", accounts); err != nil {
 		return diag.FromErr(err)
 	}
-
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(q.id())
 
 	return diag.Diagnostics{}
 }
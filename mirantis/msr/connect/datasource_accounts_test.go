@@ -0,0 +1,88 @@
+package connect
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccountsQueryMatches(t *testing.T) {
+	local, imported := false, true
+	accounts := []client.ResponseAccount{
+		{Name: "dev-alice"},
+		{Name: "dev-bob", IsImported: true},
+		{Name: "ops-carol", IsImported: true},
+	}
+	testCases := map[string]struct {
+		query    accountsQuery
+		expected []string
+	}{
+		"no filter": {
+			query:    accountsQuery{nameRegex: regexp.MustCompile("")},
+			expected: []string{"dev-alice", "dev-bob", "ops-carol"},
+		},
+		"prefix": {
+			query:    accountsQuery{nameRegex: regexp.MustCompile(""), namePrefix: "dev-"},
+			expected: []string{"dev-alice", "dev-bob"},
+		},
+		"regex": {
+			query:    accountsQuery{nameRegex: regexp.MustCompile("(alice|carol)$")},
+			expected: []string{"dev-alice", "ops-carol"},
+		},
+		"only local": {
+			query:    accountsQuery{nameRegex: regexp.MustCompile(""), isImported: &local},
+			expected: []string{"dev-alice"},
+		},
+		"only imported with prefix": {
+			query:    accountsQuery{nameRegex: regexp.MustCompile(""), namePrefix: "dev-", isImported: &imported},
+			expected: []string{"dev-bob"},
+		},
+	}
+	for name, tc := range testCases {
+		got := []string{}
+		for _, a := range accounts {
+			if tc.query.matches(a) {
+				got = append(got, a.Name)
+			}
+		}
+		if len(got) != len(tc.expected) {
+			t.Errorf("%s: expected (%v), got (%v)", name, tc.expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.expected[i] {
+				t.Errorf("%s: expected (%v), got (%v)", name, tc.expected, got)
+				break
+			}
+		}
+	}
+}
+
+func TestAccountsQueryStableID(t *testing.T) {
+	raw := map[string]interface{}{
+		"filter":      string(client.Users),
+		"name_prefix": "dev-",
+		"name_regex":  "^dev-[a-z]+$",
+	}
+	first := accountsQueryFromResourceData(schema.TestResourceDataRaw(t, dataSourceAccounts().Schema, raw)).id()
+	second := accountsQueryFromResourceData(schema.TestResourceDataRaw(t, dataSourceAccounts().Schema, raw)).id()
+	if first != second {
+		t.Errorf("expected the same query to give the same ID, got (%s) and (%s)", first, second)
+	}
+
+	raw["name_prefix"] = "ops-"
+	if other := accountsQueryFromResourceData(schema.TestResourceDataRaw(t, dataSourceAccounts().Schema, raw)).id(); other == first {
+		t.Errorf("expected another query to give another ID, got (%s) for both", first)
+	}
+
+	// Filtering on local accounts is a different query than not filtering at all
+	local := false
+	q := accountsQuery{filter: string(client.All), nameRegex: regexp.MustCompile("")}
+	withImported := q
+	withImported.isImported = &local
+	if q.id() == withImported.id() {
+		t.Errorf("expected is_imported = false to change the ID, got (%s) for both", q.id())
+	}
+}
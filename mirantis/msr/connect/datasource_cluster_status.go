@@ -0,0 +1,129 @@
+package connect
+
+import (
+	"context"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const clusterStatusID = "msr-cluster-status"
+
+// dataSourceClusterStatus for retrieving the status of every MSR replica
+func dataSourceClusterStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterStatusRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replicas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"healthy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"all_replicas_healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rethinkdb_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rethinkdb_unready_tables": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "'db.table' names of the tables which don't have all of their replicas ready.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"etcd_healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"gc_lock_holder": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if diags := requireMSRVersion(c, "mirantis-msr-connect_cluster_status"); diags.HasError() {
+		return diags
+	}
+
+	status, err := c.ReadClusterStatus(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// MSR is only asked for the version again when the provider couldn't load it
+	version := c.Version.String()
+	if !c.Version.IsKnown() {
+		if version, err = c.GetMSRVersion(ctx); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	replicas := make([]map[string]interface{}, 0, len(status.ReplicaHealth))
+	for _, id := range status.ReplicaIDs() {
+		replicas = append(replicas, map[string]interface{}{
+			"id":       id,
+			"health":   status.ReplicaHealth[id],
+			"healthy":  status.ReplicaHealth[id] == client.ReplicaHealthy,
+			"readonly": status.ReplicaReadonly[id],
+		})
+	}
+	servers := make([]string, 0, len(status.RethinkSystemTables.ServerStatus))
+	for _, s := range status.RethinkSystemTables.ServerStatus {
+		servers = append(servers, s.Name)
+	}
+
+	values := map[string]interface{}{
+		"version":                  version,
+		"replicas":                 replicas,
+		"all_replicas_healthy":     status.AllReplicasHealthy(),
+		"rethinkdb_servers":        servers,
+		"rethinkdb_unready_tables": status.UnreadyTables(),
+		"etcd_healthy":             status.EtcdStatus.Healthy,
+		"gc_lock_holder":           status.GCLockHolder,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(clusterStatusID)
+
+	return diag.Diagnostics{}
+}
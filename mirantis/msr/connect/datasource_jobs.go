@@ -0,0 +1,124 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceJobs for auditing the recent MSR jobs
+func dataSourceJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJobsRead,
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"worker": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"running": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "any",
+				ValidateFunc: validation.StringInSlice([]string{"any", "true", "false"}, false),
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"jobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"worker_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scheduled_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retries_left": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceJobsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if diags := requireMSRVersion(c, "mirantis-msr-connect_jobs"); diags.HasError() {
+		return diags
+	}
+
+	filter := client.JobFilter{
+		Action: d.Get("action").(string),
+		Worker: d.Get("worker").(string),
+		Limit:  d.Get("limit").(int),
+	}
+	running := d.Get("running").(string)
+	if running != "any" {
+		r := running == "true"
+		filter.Running = &r
+	}
+
+	rJobs, err := c.ReadJobs(ctx, filter)
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	jobs := make([]map[string]interface{}, 0, len(rJobs))
+	for _, j := range rJobs {
+		jobs = append(jobs, map[string]interface{}{
+			"id":           j.ID,
+			"action":       j.Action,
+			"status":       j.Status,
+			"worker_id":    j.WorkerID,
+			"scheduled_at": j.ScheduledAt.Format(time.RFC3339),
+			"last_updated": j.LastUpdated.Format(time.RFC3339),
+			"retries_left": j.RetriesLeft,
+		})
+	}
+	if err := d.Set("jobs", jobs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("jobs/%s/%s/%s/%d", filter.Action, filter.Worker, running, filter.Limit))
+
+	return diag.Diagnostics{}
+}
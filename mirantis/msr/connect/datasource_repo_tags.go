@@ -0,0 +1,150 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceRepoTags for retrieving the tags of a MSR repository
+func dataSourceRepoTags() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRepoTagsRead,
+		Schema: map[string]*schema.Schema{
+			"org_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repo_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return tags whose name matches this regular expression.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"latest": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Only return the N most recently pushed tags.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scan_status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"scan_completed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRepoTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if diags := requireMSRVersion(c, "mirantis-msr-connect_repo_tags"); diags.HasError() {
+		return diags
+	}
+
+	repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+	rTags, err := c.ReadRepoTags(ctx, repoName)
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		re := regexp.MustCompile(v.(string))
+		filtered := make([]client.ResponseTag, 0, len(rTags))
+		for _, t := range rTags {
+			if re.MatchString(t.Name) {
+				filtered = append(filtered, t)
+			}
+		}
+		rTags = filtered
+	}
+
+	// Most recently pushed tags first
+	sort.SliceStable(rTags, func(i, j int) bool {
+		return rTags[i].UpdatedAt.After(rTags[j].UpdatedAt)
+	})
+
+	if v, ok := d.GetOk("latest"); ok && v.(int) < len(rTags) {
+		rTags = rTags[:v.(int)]
+	}
+
+	tags := make([]map[string]interface{}, 0, len(rTags))
+	for _, t := range rTags {
+		tag := map[string]interface{}{
+			"name":         t.Name,
+			"digest":       t.Digest,
+			"architecture": t.Manifest.Architecture,
+			"os":           t.Manifest.OS,
+			"size":         t.Manifest.Size,
+			"created_at":   t.CreatedAt.Format(time.RFC3339),
+			"updated_at":   t.UpdatedAt.Format(time.RFC3339),
+		}
+		if t.VulnSummary != nil {
+			tag["scan_status"] = t.VulnSummary.LastScanStatus
+			tag["scan_completed_at"] = t.VulnSummary.CheckCompletedAt.Format(time.RFC3339)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(repoName)
+
+	return diag.Diagnostics{}
+}
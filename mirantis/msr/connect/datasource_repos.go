@@ -0,0 +1,118 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceRepos for listing MSR repositories
+func dataSourceRepos() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceReposRead,
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the repositories of this namespace, all namespaces if not set.",
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+			},
+			"repos": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"visibility": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pulls": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pushes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"scan_on_push": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tag_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReposRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if diags := requireMSRVersion(c, "mirantis-msr-connect_repos"); diags.HasError() {
+		return diags
+	}
+
+	namespace := d.Get("namespace").(string)
+	visibility := d.Get("visibility").(string)
+	rRepos, err := c.ReadRepos(ctx, namespace)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	repos := make([]map[string]interface{}, 0, len(rRepos))
+	for _, r := range rRepos {
+		if visibility != "" && r.Visibility != visibility {
+			continue
+		}
+		repos = append(repos, map[string]interface{}{
+			"id":           r.ID,
+			"name":         r.Name,
+			"namespace":    r.Namespace,
+			"visibility":   r.Visibility,
+			"pulls":        r.Pulls,
+			"pushes":       r.Pushes,
+			"scan_on_push": r.ScanOnPush,
+			"tag_limit":    r.TagLimit,
+		})
+	}
+
+	if err := d.Set("repos", repos); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if namespace == "" {
+		namespace = "*"
+	}
+	if visibility == "" {
+		visibility = "*"
+	}
+	d.SetId(fmt.Sprintf("%s/%s", namespace, visibility))
+
+	return diag.Diagnostics{}
+}
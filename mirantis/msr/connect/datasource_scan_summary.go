@@ -0,0 +1,274 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scanStatusDescription documents the values of the client.ScanStatus* constants
+const scanStatusDescription = "0 failed, 1 unscanned, 2 scanning, 3 pending, 4 scanned, 5 checking for vulnerabilities, 6 completed."
+
+// dataSourceScanSummary for retrieving the vulnerability scan results of a MSR image
+func dataSourceScanSummary() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScanSummaryRead,
+		Schema: map[string]*schema.Schema{
+			"org_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repo_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tag": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"critical": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Critical vulnerabilities of all the platforms, each counted once when MSR reports the layer details of every platform.",
+			},
+			"major": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"minor": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"scan_status": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Worst scan status of all the platforms. " + scanStatusDescription,
+			},
+			"platforms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"critical": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"major": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"minor": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"scan_status": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: scanStatusDescription,
+						},
+					},
+				},
+			},
+			"scan_completed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cves": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Sorted, de-duplicated IDs of every vulnerability found in the image.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"components": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cves": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScanSummaryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if diags := requireMSRVersion(c, "mirantis-msr-connect_scan_summary"); diags.HasError() {
+		return diags
+	}
+
+	repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+	tag := d.Get("tag").(string)
+	summaries, err := c.ReadScanSummary(ctx, repoName, tag)
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	// A multi platform tag has a summary per platform and the platforms share most of
+	// their vulnerabilities, so every CVE is counted once with its highest CVSS score.
+	// The scan status is the worst one and the per platform results are kept in platforms.
+	status := client.ScanStatusUnscanned
+	completedAt := time.Time{}
+	type componentKey struct{ name, version string }
+	cves := map[string]bool{}
+	platforms := make([]map[string]interface{}, 0, len(summaries))
+	components := map[componentKey]map[string]bool{}
+	for i, s := range summaries {
+		if i == 0 || s.LastScanStatus < status {
+			status = s.LastScanStatus
+		}
+		if s.CheckCompletedAt.After(completedAt) {
+			completedAt = s.CheckCompletedAt
+		}
+		platforms = append(platforms, map[string]interface{}{
+			"os":           s.OS,
+			"architecture": s.Architecture,
+			"critical":     s.Critical,
+			"major":        s.Major,
+			"minor":        s.Minor,
+			"scan_status":  s.LastScanStatus,
+		})
+		for _, l := range s.Layers {
+			for _, comp := range l.Components {
+				key := componentKey{comp.Component, comp.Version}
+				if _, ok := components[key]; !ok {
+					components[key] = map[string]bool{}
+				}
+				for _, v := range comp.Vulns {
+					cves[v.Vuln.ID] = true
+					components[key][v.Vuln.ID] = true
+				}
+			}
+		}
+	}
+	counts := scanSeverityCounts(summaries)
+
+	compKeys := make([]componentKey, 0, len(components))
+	for k := range components {
+		compKeys = append(compKeys, k)
+	}
+	sort.Slice(compKeys, func(i, j int) bool {
+		if compKeys[i].name != compKeys[j].name {
+			return compKeys[i].name < compKeys[j].name
+		}
+		return compKeys[i].version < compKeys[j].version
+	})
+	flatComponents := make([]map[string]interface{}, 0, len(compKeys))
+	for _, k := range compKeys {
+		flatComponents = append(flatComponents, map[string]interface{}{
+			"name":    k.name,
+			"version": k.version,
+			"cves":    sortedKeys(components[k]),
+		})
+	}
+
+	values := map[string]interface{}{
+		"critical":          counts[client.SeverityCritical],
+		"major":             counts[client.SeverityMajor],
+		"minor":             counts[client.SeverityMinor],
+		"scan_status":       status,
+		"scan_completed_at": completedAt.Format(time.RFC3339),
+		"cves":              sortedKeys(cves),
+		"components":        flatComponents,
+		"platforms":         platforms,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoName, tag))
+
+	return diag.Diagnostics{}
+}
+
+// scanSeverityCounts counts the vulnerabilities of the summaries by severity. With the
+// layer details of every platform each CVE is counted once, under the severity of its
+// highest CVSS score. Without them the counts can't be deduplicated, so the largest
+// count of a platform is taken as a lower bound.
+func scanSeverityCounts(summaries []client.ScanSummary) map[string]int {
+	detailed := len(summaries) > 0
+	for _, s := range summaries {
+		if len(s.Layers) == 0 {
+			detailed = false
+		}
+	}
+
+	counts := map[string]int{}
+	if !detailed {
+		for _, s := range summaries {
+			platformCounts := map[string]int{
+				client.SeverityCritical: s.Critical,
+				client.SeverityMajor:    s.Major,
+				client.SeverityMinor:    s.Minor,
+			}
+			for sev, n := range platformCounts {
+				if n > counts[sev] {
+					counts[sev] = n
+				}
+			}
+		}
+		return counts
+	}
+
+	scores := map[string]float64{}
+	for _, s := range summaries {
+		for _, l := range s.Layers {
+			for _, comp := range l.Components {
+				for _, v := range comp.Vulns {
+					if score, ok := scores[v.Vuln.ID]; !ok || v.Vuln.CVSS > score {
+						scores[v.Vuln.ID] = v.Vuln.CVSS
+					}
+				}
+			}
+		}
+	}
+	for id, score := range scores {
+		counts[client.ScanVuln{ID: id, CVSS: score}.Severity()]++
+	}
+	return counts
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
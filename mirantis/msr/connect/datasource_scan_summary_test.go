@@ -0,0 +1,60 @@
+package connect
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+)
+
+func TestScanSeverityCounts(t *testing.T) {
+	testCases := map[string]struct {
+		summaries string
+		expected  map[string]int
+	}{
+		"deduplicated across platforms": {
+			// CVE-1 is minor on amd64 but critical on arm64, so it only counts as critical
+			summaries: `[
+				{"critical": 0, "major": 1, "minor": 1, "layers": [{"components": [{"component": "openssl", "version": "1.1", "vulns": [
+					{"vuln": {"id": "CVE-1", "cvss": 3.1}},
+					{"vuln": {"id": "CVE-2", "cvss": 5.0}}
+				]}]}]},
+				{"critical": 1, "major": 1, "minor": 0, "layers": [{"components": [{"component": "openssl", "version": "1.1", "vulns": [
+					{"vuln": {"id": "CVE-1", "cvss": 7.5}},
+					{"vuln": {"id": "CVE-2", "cvss": 5.0}}
+				]}]}]}
+			]`,
+			expected: map[string]int{client.SeverityCritical: 1, client.SeverityMajor: 1},
+		},
+		"without layer details": {
+			summaries: `[
+				{"critical": 2, "major": 1, "minor": 4},
+				{"critical": 1, "major": 3, "minor": 4}
+			]`,
+			expected: map[string]int{client.SeverityCritical: 2, client.SeverityMajor: 3, client.SeverityMinor: 4},
+		},
+		"a platform without layer details": {
+			summaries: `[
+				{"critical": 1, "major": 0, "minor": 0, "layers": [{"components": [{"component": "bash", "version": "5", "vulns": [
+					{"vuln": {"id": "CVE-3", "cvss": 9.8}}
+				]}]}]},
+				{"critical": 0, "major": 2, "minor": 0}
+			]`,
+			expected: map[string]int{client.SeverityCritical: 1, client.SeverityMajor: 2},
+		},
+		"no summaries": {
+			summaries: `[]`,
+			expected:  map[string]int{},
+		},
+	}
+	for name, tc := range testCases {
+		summaries := []client.ScanSummary{}
+		if err := json.Unmarshal([]byte(tc.summaries), &summaries); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if counts := scanSeverityCounts(summaries); !reflect.DeepEqual(tc.expected, counts) {
+			t.Errorf("%s: expected (%v), got (%v)", name, tc.expected, counts)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceTeamMembers for listing the members of a MSR team
+func dataSourceTeamMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTeamMembersRead,
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name or ID of the org.",
+			},
+			"team": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name or ID of the team.",
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"full_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_admin": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the member is an admin of the team.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTeamMembersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if diags := requireMSRVersion(c, "mirantis-msr-connect_team_members"); diags.HasError() {
+		return diags
+	}
+
+	org := d.Get("org").(string)
+	t, err := c.ReadTeam(ctx, org, d.Get("team").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rMembers, err := c.GetTeamUsers(ctx, org, t.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members := make([]map[string]interface{}, 0, len(rMembers))
+	for _, tm := range rMembers {
+		members = append(members, map[string]interface{}{
+			"id":        tm.Member.ID,
+			"name":      tm.Member.Name,
+			"full_name": tm.Member.FullName,
+			"is_active": tm.Member.IsActive,
+			"is_admin":  tm.IsAdmin,
+		})
+	}
+
+	if err := d.Set("team_id", t.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", org, t.ID))
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,80 @@
+package connect
+
+import (
+	"context"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceTeams for listing the teams of a MSR org
+func dataSourceTeams() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTeamsRead,
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name or ID of the org.",
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTeamsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if diags := requireMSRVersion(c, "mirantis-msr-connect_teams"); diags.HasError() {
+		return diags
+	}
+
+	org := d.Get("org").(string)
+	rTeams, err := c.ReadTeams(ctx, org)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teams := make([]map[string]interface{}, 0, len(rTeams))
+	for _, t := range rTeams {
+		teams = append(teams, map[string]interface{}{
+			"id":            t.ID,
+			"name":          t.Name,
+			"description":   t.Description,
+			"members_count": t.MembersCount,
+		})
+	}
+
+	if err := d.Set("teams", teams); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(org)
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,96 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceWebhookCheck for making MSR send a test event to a webhook endpoint
+func dataSourceWebhookCheck() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWebhookCheckRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(client.WebhookTypes, false),
+			},
+			"endpoint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"authorization": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"ca_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"success": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"error": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceWebhookCheckRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if diags := requireMSRVersion(c, "mirantis-msr-connect_webhook_check"); diags.HasError() {
+		return diags
+	}
+
+	hook := client.TestWebhook{
+		Type:          d.Get("type").(string),
+		Endpoint:      d.Get("endpoint").(string),
+		Authorization: d.Get("authorization").(string),
+		TLSCert:       d.Get("ca_cert").(string),
+		ClientCert:    d.Get("client_cert").(string),
+		ClientKey:     d.Get("client_key").(string),
+	}
+
+	// A failed delivery is reported through the attributes so it can be used in preconditions,
+	// any other failure means the check couldn't be run at all
+	success, errMsg := true, ""
+	if err := c.TestWebhook(ctx, hook); err != nil {
+		if !errors.Is(err, client.ErrWebhookDelivery) {
+			return diag.FromErr(err)
+		}
+		success, errMsg = false, err.Error()
+	}
+	if err := d.Set("success", success); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("error", errMsg); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", hook.Type, hook.Endpoint))
+
+	return diag.Diagnostics{}
+}
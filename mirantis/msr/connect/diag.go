@@ -0,0 +1,31 @@
+package connect
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// diagFromAPIError turns every error MSR reported for a rejected request into its own diagnostic
+func diagFromAPIError(summary string, err error) diag.Diagnostics {
+	apiErr := &client.APIError{}
+	if !errors.As(err, &apiErr) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   err.Error(),
+		}}
+	}
+
+	diags := make(diag.Diagnostics, 0, len(apiErr.Errors))
+	for _, e := range apiErr.Errors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("MSR rejected the request (%d %s): %s", apiErr.StatusCode, e.Code, e.Message),
+		})
+	}
+	return diags
+}
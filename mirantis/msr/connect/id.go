@@ -0,0 +1,25 @@
+package connect
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidCompositeID = errors.New("resource ID does not have the expected number of '/' separated parts")
+)
+
+// splitCompositeID splits a '/' separated resource ID into exactly n non empty parts
+func splitCompositeID(id string, n int) ([]string, error) {
+	parts := strings.SplitN(id, "/", n)
+	if len(parts) != n {
+		return nil, fmt.Errorf("%w: expected %d, got '%s'", ErrInvalidCompositeID, n, id)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("%w: expected %d, got '%s'", ErrInvalidCompositeID, n, id)
+		}
+	}
+	return parts, nil
+}
@@ -0,0 +1,73 @@
+package connect_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	connect "github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func importID(t *testing.T, r *schema.Resource, id string, c client.Client) (*schema.ResourceData, error) {
+	d := r.TestResourceData()
+	d.SetId(id)
+	imported, err := r.Importer.StateContext(context.Background(), d, c)
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) != 1 {
+		t.Fatalf("expected a single imported resource, got (%d)", len(imported))
+	}
+	return imported[0], nil
+}
+
+func TestTeamImport(t *testing.T) {
+	c := apiServer(t, map[string]string{
+		"/enzi/v0/accounts/dev":              `{"name":"dev","id":"org-id","isOrg":true}`,
+		"/enzi/v0/accounts/org-id/teams/ops": `{"name":"ops","id":"team-id","orgID":"org-id"}`,
+	})
+
+	d, err := importID(t, connect.ResourceTeam(), "dev/ops", c)
+	if err != nil {
+		t.Fatalf("expected (%v), got (%v)", nil, err)
+	}
+	if d.Id() != "team-id" || d.Get("org_id") != "org-id" || d.Get("name") != "ops" {
+		t.Errorf("unexpected import, id (%s), org_id (%v), name (%v)", d.Id(), d.Get("org_id"), d.Get("name"))
+	}
+
+	for _, id := range []string{"dev", "dev/", "/ops"} {
+		if _, err := importID(t, connect.ResourceTeam(), id, c); !errors.Is(err, connect.ErrInvalidCompositeID) {
+			t.Errorf("%s: expected (%v), got (%v)", id, connect.ErrInvalidCompositeID, err)
+		}
+	}
+	if _, err := importID(t, connect.ResourceTeam(), "dev/missing", c); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("expected (%v), got (%v)", client.ErrNotFound, err)
+	}
+}
+
+func TestRepoImport(t *testing.T) {
+	c := apiServer(t, map[string]string{
+		"/enzi/v0/accounts/dev":        `{"name":"dev","id":"org-id","isOrg":true}`,
+		"/enzi/v0/accounts/org-id":     `{"name":"dev","id":"org-id","isOrg":true}`,
+		"/api/v0/repositories/dev/app": `{"id":"repo-id","name":"app","namespace":"dev","visibility":"private"}`,
+	})
+
+	// The namespace can be given by name or by ID
+	for _, id := range []string{"dev/app", "org-id/app"} {
+		d, err := importID(t, connect.ResourceRepo(), id, c)
+		if err != nil {
+			t.Fatalf("%s: expected (%v), got (%v)", id, nil, err)
+		}
+		if d.Id() != "dev/app" || d.Get("org_name") != "dev" || d.Get("name") != "app" {
+			t.Errorf("%s: unexpected import, id (%s), org_name (%v), name (%v)", id, d.Id(), d.Get("org_name"), d.Get("name"))
+		}
+	}
+
+	for _, id := range []string{"dev", "dev/app/extra", "/app"} {
+		if _, err := importID(t, connect.ResourceRepo(), id, c); !errors.Is(err, client.ErrIDHasNoRepoName) {
+			t.Errorf("%s: expected (%v), got (%v)", id, client.ErrIDHasNoRepoName, err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package connect
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,15 +18,24 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("MSR_HOST_URL", nil),
 			},
 			"username": {
-				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("MSR_ADMIN_USER", nil),
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSR_ADMIN_USER", nil),
+				RequiredWith: []string{"password"},
 			},
 			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSR_ADMIN_PASS", nil),
+				RequiredWith: []string{"username"},
+			},
+			"token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				DefaultFunc: schema.EnvDefaultFunc("MSR_ADMIN_PASS", nil),
+				DefaultFunc: schema.EnvDefaultFunc("MSR_TOKEN", nil),
+				Description: "MSR access token, takes priority over username and password when both are set.",
 			},
 			"unsafe_ssl_client": {
 				Type:        schema.TypeBool,
@@ -35,14 +45,35 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"mirantis-msr-connect_user": ResourceUser(),
-			"mirantis-msr-connect_org":  ResourceOrg(),
-			"mirantis-msr-connect_team": ResourceTeam(),
-			"mirantis-msr-connect_repo": ResourceRepo(),
+			"mirantis-msr-connect_user":                  ResourceUser(),
+			"mirantis-msr-connect_org":                   ResourceOrg(),
+			"mirantis-msr-connect_team":                  ResourceTeam(),
+			"mirantis-msr-connect_repo":                  ResourceRepo(),
+			"mirantis-msr-connect_promotion_policy":      ResourcePromotionPolicy(),
+			"mirantis-msr-connect_push_mirroring_policy": ResourcePushMirroringPolicy(),
+			"mirantis-msr-connect_poll_mirroring_policy": ResourcePollMirroringPolicy(),
+			"mirantis-msr-connect_webhook":               ResourceWebhook(),
+			"mirantis-msr-connect_pruning_policy":        ResourcePruningPolicy(),
+			"mirantis-msr-connect_settings":              ResourceSettings(),
+			"mirantis-msr-connect_storage":               ResourceStorage(),
+			"mirantis-msr-connect_cron":                  ResourceCron(),
+			"mirantis-msr-connect_vuln_db":               ResourceVulnDB(),
+			"mirantis-msr-connect_access_token":          ResourceAccessToken(),
+			"mirantis-msr-connect_ldap":                  ResourceLDAP(),
+			"mirantis-msr-connect_org_member":            ResourceOrgMember(),
+			"mirantis-msr-connect_team_member":           ResourceTeamMember(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"mirantis-msr-connect_accounts": dataSourceAccounts(),
-			"mirantis-msr-connect_account":  dataSourceAccount(),
+			"mirantis-msr-connect_accounts":       dataSourceAccounts(),
+			"mirantis-msr-connect_account":        dataSourceAccount(),
+			"mirantis-msr-connect_repo_tags":      dataSourceRepoTags(),
+			"mirantis-msr-connect_webhook_check":  dataSourceWebhookCheck(),
+			"mirantis-msr-connect_scan_summary":   dataSourceScanSummary(),
+			"mirantis-msr-connect_jobs":           dataSourceJobs(),
+			"mirantis-msr-connect_repos":          dataSourceRepos(),
+			"mirantis-msr-connect_teams":          dataSourceTeams(),
+			"mirantis-msr-connect_team_members":   dataSourceTeamMembers(),
+			"mirantis-msr-connect_cluster_status": dataSourceClusterStatus(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -51,6 +82,7 @@ func Provider() *schema.Provider {
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
+	token := d.Get("token").(string)
 	host := d.Get("host").(string)
 	unsafeClient := d.Get("unsafe_ssl_client").(bool)
 
@@ -58,10 +90,22 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	var diags diag.Diagnostics
 	var err error
 	var c client.Client
-	if unsafeClient {
+	// The credentials often come from the environment, so having both isn't an error
+	if token != "" && password != "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Both an MSR token and a password are set",
+			Detail:   "The token is used, the username and password are ignored.",
+		})
+	}
+	switch {
+	case token != "" && unsafeClient:
+		c, err = client.NewUnsafeSSLTokenClient(host, token)
+	case token != "":
+		c, err = client.NewDefaultTokenClient(host, token)
+	case unsafeClient:
 		c, err = client.NewUnsafeSSLClient(host, username, password)
-
-	} else {
+	default:
 		c, err = client.NewDefaultClient(host, username, password)
 	}
 	if err != nil {
@@ -83,5 +127,26 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		})
 		return nil, diags
 	}
+
+	// Username and password are exchanged for a session once, so they aren't
+	// sent along with every request
+	if err := c.Login(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to log in to MSR",
+			Detail:   err.Error(),
+		})
+		return nil, diags
+	}
+
+	// The version is fetched once, resources compare it with their minimum version at plan time.
+	// Failing to fetch it leaves the version unknown, which no feature is gated on
+	if _, err := c.LoadVersion(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unable to determine the MSR version",
+			Detail:   fmt.Sprintf("Minimum MSR versions of the resources are not checked. %s", err),
+		})
+	}
 	return c, diags
 }
@@ -0,0 +1,144 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceAccessToken for managing MSR personal access tokens.
+// The secret is only known on creation, a revoked token is created again.
+func ResourceAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccessTokenCreate,
+		ReadContext:   resourceAccessTokenRead,
+		DeleteContext: resourceAccessTokenDelete,
+		CustomizeDiff: minMSRVersion("mirantis-msr-connect_access_token"),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccessTokenImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "User the token is created for, the provider user if not set.",
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"generated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccessTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	t, err := c.CreateAccessToken(ctx, d.Get("username").(string), d.Get("label").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("token", t.Token); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(t.HashedToken)
+
+	return resourceAccessTokenRead(ctx, d, m)
+}
+
+func resourceAccessTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	// The token is looked up among the tokens of its user, so a token of someone else isn't taken over
+	username := d.Get("username").(string)
+	if username == "" {
+		username = c.Creds.Username
+	}
+	t, err := c.ReadUserAccessToken(ctx, username, d.Id())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		return diag.FromErr(err)
+	}
+	// A deactivated token can't be used anymore, so it is created again as well
+	if !t.IsActive {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	values := map[string]interface{}{
+		"username":     username,
+		"label":        t.TokenLabel,
+		"generated_at": t.GeneratedAt.Format(time.RFC3339),
+		"last_used":    t.LastUsed.Format(time.RFC3339),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.Diagnostics{}
+}
+
+// resourceAccessTokenImport takes either 'username/hashed_token' or the hashed token of the
+// provider user, the token secret itself can't be imported
+func resourceAccessTokenImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), "/") {
+		parts, err := splitCompositeID(d.Id(), 2)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Set("username", parts[0]); err != nil {
+			return nil, err
+		}
+		d.SetId(parts[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAccessTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if err := c.RevokeAccessToken(ctx, d.Id()); err != nil && !errors.Is(err, client.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,156 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceCron for managing MSR scheduled jobs
+func ResourceCron() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCronCreate,
+		ReadContext:   resourceCronRead,
+		UpdateContext: resourceCronUpdate,
+		DeleteContext: resourceCronDelete,
+		CustomizeDiff: minMSRVersion("mirantis-msr-connect_cron"),
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Job action to schedule, e.g. 'onlinegc' for the garbage collection or 'update_vuln_db'.",
+			},
+			"schedule": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cron schedule with seconds, e.g. '0 0 1 * * 6'.",
+			},
+			"retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"deadline": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Duration after which the job is stopped, e.g. '4h'.",
+			},
+			"stop_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"next_run": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func cronFromResourceData(d *schema.ResourceData) client.Cron {
+	return client.Cron{
+		Schedule:    d.Get("schedule").(string),
+		Retries:     d.Get("retries").(int),
+		Deadline:    d.Get("deadline").(string),
+		StopTimeout: d.Get("stop_timeout").(string),
+	}
+}
+
+func resourceCronCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	action := d.Get("action").(string)
+	if _, err := c.UpdateCron(ctx, action, cronFromResourceData(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(action)
+
+	return resourceCronRead(ctx, d, m)
+}
+
+func resourceCronRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	cron, err := c.ReadCron(ctx, d.Id())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"action":       d.Id(),
+		"schedule":     cron.Schedule,
+		"retries":      cron.Retries,
+		"deadline":     cron.Deadline,
+		"stop_timeout": cron.StopTimeout,
+		"next_run":     cron.NextRun.Format(time.RFC3339),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.Diagnostics{}
+}
+
+func resourceCronUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if d.HasChanges("schedule", "retries", "deadline", "stop_timeout") {
+		if _, err := c.UpdateCron(ctx, d.Id(), cronFromResourceData(d)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCronRead(ctx, d, m)
+}
+
+func resourceCronDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if err := c.DeleteCron(ctx, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,372 @@
+package connect
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const ldapID = "msr-ldap"
+
+// ldapServerSchema holds the connection settings shared by the main server
+// and the additional domain servers
+func ldapServerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"server_url": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"no_simple_pagination": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"start_tls": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"root_certs": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"tls_skip_verify": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"reader_dn": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"reader_password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+// ResourceLDAP for managing the enzi LDAP authentication.
+// It is a singleton, deleting it switches enzi back to managed authentication.
+func ResourceLDAP() *schema.Resource {
+	s := ldapServerSchema()
+	domain := ldapServerSchema()
+	domain["domain"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["additional_domain"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: domain,
+		},
+	}
+	s["user_search"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"base_dn": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"scope_subtree": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"username_attribute": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "uid",
+				},
+				"full_name_attribute": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"filter": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"match_group_dn": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Only sync users which are members of this group.",
+				},
+				"match_group_member_attribute": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "member",
+				},
+				"match_group_iterate": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+	s["recovery_admin_username"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["recovery_admin_password"] = &schema.Schema{
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	}
+	s["sync_schedule"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "@hourly",
+	}
+	s["jit_user_provisioning"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+	s["test_login"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Credentials logged in with before the settings are saved, a failed login aborts the apply.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"username": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"password": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+	s["last_updated"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceLDAPCreate,
+		ReadContext:   resourceLDAPRead,
+		UpdateContext: resourceLDAPUpdate,
+		DeleteContext: resourceLDAPDelete,
+		CustomizeDiff: minMSRVersion("mirantis-msr-connect_ldap"),
+		Schema:        s,
+	}
+}
+
+func expandLDAPServer(m map[string]interface{}) client.LDAPServer {
+	s := client.LDAPServer{
+		ServerURL:          m["server_url"].(string),
+		NoSimplePagination: m["no_simple_pagination"].(bool),
+		StartTLS:           m["start_tls"].(bool),
+		RootCerts:          m["root_certs"].(string),
+		TLSSkipVerify:      m["tls_skip_verify"].(bool),
+		ReaderDN:           m["reader_dn"].(string),
+		ReaderPassword:     m["reader_password"].(string),
+	}
+	if domain, ok := m["domain"]; ok {
+		s.Domain = domain.(string)
+	}
+	return s
+}
+
+func flattenLDAPServer(s client.LDAPServer, readerPassword interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"server_url":           s.ServerURL,
+		"no_simple_pagination": s.NoSimplePagination,
+		"start_tls":            s.StartTLS,
+		"root_certs":           s.RootCerts,
+		"tls_skip_verify":      s.TLSSkipVerify,
+		"reader_dn":            s.ReaderDN,
+		"reader_password":      readerPassword,
+	}
+}
+
+func expandLDAPSettings(d *schema.ResourceData) client.LDAPSettings {
+	s := client.LDAPSettings{
+		LDAPServer: expandLDAPServer(map[string]interface{}{
+			"server_url":           d.Get("server_url"),
+			"no_simple_pagination": d.Get("no_simple_pagination"),
+			"start_tls":            d.Get("start_tls"),
+			"root_certs":           d.Get("root_certs"),
+			"tls_skip_verify":      d.Get("tls_skip_verify"),
+			"reader_dn":            d.Get("reader_dn"),
+			"reader_password":      d.Get("reader_password"),
+		}),
+		RecoveryAdminUsername: d.Get("recovery_admin_username").(string),
+		RecoveryAdminPassword: d.Get("recovery_admin_password").(string),
+		AdditionalDomains:     []client.LDAPServer{},
+		UserSearchConfigs:     []client.LDAPUserSearchConfig{},
+		SyncSchedule:          d.Get("sync_schedule").(string),
+		JITUserProvisioning:   d.Get("jit_user_provisioning").(bool),
+	}
+	for _, v := range d.Get("additional_domain").([]interface{}) {
+		s.AdditionalDomains = append(s.AdditionalDomains, expandLDAPServer(v.(map[string]interface{})))
+	}
+	for _, v := range d.Get("user_search").([]interface{}) {
+		m := v.(map[string]interface{})
+		groupDN := m["match_group_dn"].(string)
+		s.UserSearchConfigs = append(s.UserSearchConfigs, client.LDAPUserSearchConfig{
+			BaseDN:               m["base_dn"].(string),
+			ScopeSubtree:         m["scope_subtree"].(bool),
+			UsernameAttr:         m["username_attribute"].(string),
+			FullNameAttr:         m["full_name_attribute"].(string),
+			Filter:               m["filter"].(string),
+			MatchGroup:           groupDN != "",
+			MatchGroupDN:         groupDN,
+			MatchGroupMemberAttr: m["match_group_member_attribute"].(string),
+			MatchGroupIterate:    m["match_group_iterate"].(bool),
+		})
+	}
+	return s
+}
+
+// applyLDAPSettings tries the test login first if one is configured, so broken
+// settings never lock users out, then saves the settings and enables LDAP
+func applyLDAPSettings(ctx context.Context, c client.Client, d *schema.ResourceData) diag.Diagnostics {
+	s := expandLDAPSettings(d)
+	if b := block(d.Get, "test_login"); b != nil {
+		login := client.LDAPTestLogin{
+			Username:     b["username"].(string),
+			Password:     b["password"].(string),
+			LDAPSettings: s,
+		}
+		if _, err := c.TestLDAPLogin(ctx, login); err != nil {
+			return diagFromAPIError("LDAP test login failed, the settings were not saved", err)
+		}
+	}
+	if _, err := c.UpdateLDAPSettings(ctx, s); err != nil {
+		return diagFromAPIError("Unable to configure LDAP", err)
+	}
+	if _, err := c.UpdateAuthConfig(ctx, client.AuthConfig{Backend: client.LDAPAuthBackend}); err != nil {
+		return diagFromAPIError("Unable to enable LDAP authentication", err)
+	}
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.Diagnostics{}
+}
+
+func resourceLDAPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if diags := applyLDAPSettings(ctx, c, d); diags.HasError() {
+		return diags
+	}
+	d.SetId(ldapID)
+
+	return resourceLDAPRead(ctx, d, m)
+}
+
+func resourceLDAPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	a, err := c.ReadAuthConfig(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// LDAP got disabled out of band, so it has to be enabled again
+	if a.Backend != client.LDAPAuthBackend {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+
+	s, err := c.ReadLDAPSettings(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Passwords are never returned by MSR, so they are kept as configured
+	domainPasswords := map[string]interface{}{}
+	for _, v := range d.Get("additional_domain").([]interface{}) {
+		b := v.(map[string]interface{})
+		domainPasswords[b["domain"].(string)] = b["reader_password"]
+	}
+	domains := []interface{}{}
+	for _, ad := range s.AdditionalDomains {
+		password, ok := domainPasswords[ad.Domain]
+		if !ok {
+			password = ""
+		}
+		domain := flattenLDAPServer(ad, password)
+		domain["domain"] = ad.Domain
+		domains = append(domains, domain)
+	}
+	searches := []interface{}{}
+	for _, us := range s.UserSearchConfigs {
+		searches = append(searches, map[string]interface{}{
+			"base_dn":                      us.BaseDN,
+			"scope_subtree":                us.ScopeSubtree,
+			"username_attribute":           us.UsernameAttr,
+			"full_name_attribute":          us.FullNameAttr,
+			"filter":                       us.Filter,
+			"match_group_dn":               us.MatchGroupDN,
+			"match_group_member_attribute": us.MatchGroupMemberAttr,
+			"match_group_iterate":          us.MatchGroupIterate,
+		})
+	}
+
+	values := flattenLDAPServer(s.LDAPServer, d.Get("reader_password"))
+	values["additional_domain"] = domains
+	values["user_search"] = searches
+	values["recovery_admin_username"] = s.RecoveryAdminUsername
+	values["sync_schedule"] = s.SyncSchedule
+	values["jit_user_provisioning"] = s.JITUserProvisioning
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.Diagnostics{}
+}
+
+func resourceLDAPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	// Changing only the test login credentials doesn't touch the settings
+	if d.HasChangesExcept("test_login", "last_updated") {
+		if diags := applyLDAPSettings(ctx, c, d); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceLDAPRead(ctx, d, m)
+}
+
+func resourceLDAPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if _, err := c.UpdateAuthConfig(ctx, client.AuthConfig{Backend: client.ManagedAuthBackend}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
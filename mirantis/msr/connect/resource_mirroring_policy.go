@@ -0,0 +1,232 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourcePushMirroringPolicy for managing MSR repository push mirroring policies
+func ResourcePushMirroringPolicy() *schema.Resource {
+	return resourceMirroringPolicy(client.PushMirroring)
+}
+
+// ResourcePollMirroringPolicy for managing MSR repository poll mirroring policies
+func ResourcePollMirroringPolicy() *schema.Resource {
+	return resourceMirroringPolicy(client.PollMirroring)
+}
+
+// resourceMirroringPolicy builds the resource for either direction, they only differ in their endpoint
+func resourceMirroringPolicy(kind client.MirroringPolicyKind) *schema.Resource {
+	name := "mirantis-msr-connect_push_mirroring_policy"
+	if kind == client.PollMirroring {
+		name = "mirantis-msr-connect_poll_mirroring_policy"
+	}
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceMirroringPolicyCreate(ctx, d, m, kind)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceMirroringPolicyRead(ctx, d, m, kind)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceMirroringPolicyUpdate(ctx, d, m, kind)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceMirroringPolicyDelete(ctx, d, m, kind)
+		},
+		CustomizeDiff: minMSRVersion(name),
+		Schema: map[string]*schema.Schema{
+			"org_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repo_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remote_host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "URL of the remote registry, e.g. 'https://registry-1.docker.io'.",
+			},
+			"remote_repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Repository on the remote registry, in the 'namespace/repo' form.",
+			},
+			"remote_ca": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate of the remote registry.",
+			},
+			"skip_tls_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"auth_token"},
+			},
+			"auth_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password"},
+			},
+			"tag_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%n",
+				Description: "Template for the name of the mirrored tag, '%n' being the source tag name.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"rule": policyRuleSchema(),
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func mirroringPolicyFromResourceData(d *schema.ResourceData) client.MirroringPolicy {
+	return client.MirroringPolicy{
+		Enabled:             d.Get("enabled").(bool),
+		Rules:               expandPolicyRules(d.Get("rule").([]interface{})),
+		RemoteHost:          d.Get("remote_host").(string),
+		RemoteRepository:    d.Get("remote_repository").(string),
+		RemoteCA:            d.Get("remote_ca").(string),
+		SkipTLSVerification: d.Get("skip_tls_verification").(bool),
+		TagTemplate:         d.Get("tag_template").(string),
+		Username:            d.Get("username").(string),
+		Password:            d.Get("password").(string),
+		AuthToken:           d.Get("auth_token").(string),
+	}
+}
+
+func resourceMirroringPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}, kind client.MirroringPolicyKind) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+	p, err := c.CreateMirroringPolicy(ctx, kind, repoName, mirroringPolicyFromResourceData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", repoName, p.ID))
+
+	return resourceMirroringPolicyRead(ctx, d, m, kind)
+}
+
+func resourceMirroringPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}, kind client.MirroringPolicyKind) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	parts, err := splitCompositeID(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	repoName := fmt.Sprintf("%s/%s", parts[0], parts[1])
+
+	p, err := c.ReadMirroringPolicy(ctx, kind, repoName, parts[2])
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		return diag.FromErr(err)
+	}
+
+	// Credentials are write only in MSR, so password and auth_token are kept as configured
+	values := map[string]interface{}{
+		"org_name":              parts[0],
+		"repo_name":             parts[1],
+		"policy_id":             p.ID,
+		"remote_host":           p.RemoteHost,
+		"remote_repository":     p.RemoteRepository,
+		"remote_ca":             p.RemoteCA,
+		"skip_tls_verification": p.SkipTLSVerification,
+		"username":              p.Username,
+		"tag_template":          p.TagTemplate,
+		"enabled":               p.Enabled,
+		"rule":                  flattenPolicyRules(p.Rules),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.Diagnostics{}
+}
+
+func resourceMirroringPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}, kind client.MirroringPolicyKind) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if d.HasChangesExcept("last_updated") {
+		repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+		if _, err := c.UpdateMirroringPolicy(ctx, kind, repoName, d.Get("policy_id").(string), mirroringPolicyFromResourceData(d)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceMirroringPolicyRead(ctx, d, m, kind)
+}
+
+func resourceMirroringPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}, kind client.MirroringPolicyKind) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+	if err := c.DeleteMirroringPolicy(ctx, kind, repoName, d.Get("policy_id").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
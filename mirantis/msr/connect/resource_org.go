@@ -2,6 +2,7 @@ package connect
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
@@ -18,8 +19,10 @@ func ResourceOrg() *schema.Resource {
 		DeleteContext: resourceOrgDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Accounts can't be renamed, a new name replaces the org.",
 			},
 			"last_updated": {
 				Type:     schema.TypeString,
@@ -52,7 +55,7 @@ func resourceOrgCreate(ctx context.Context, d *schema.ResourceData, m interface{
 	}
 	d.SetId(u.ID)
 
-	return diag.Diagnostics{}
+	return resourceOrgRead(ctx, d, m)
 }
 
 func resourceOrgRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -61,13 +64,19 @@ func resourceOrgRead(ctx context.Context, d *schema.ResourceData, m interface{})
 		return diag.Errorf("unable to cast meta interface to MSR Client")
 	}
 
-	u, err := c.ReadAccount(ctx, d.State().ID)
+	u, err := c.ReadAccount(ctx, d.Id())
 	if err != nil {
 		// If the acc doesn't exist we should gracefully handle it
-		d.SetId("")
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("name", u.Name); err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId(u.ID)
 
 	return diag.Diagnostics{}
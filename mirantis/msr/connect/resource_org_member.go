@@ -0,0 +1,142 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceOrgMember for managing the membership of a user in a MSR org, independently of teams.
+// Removing a user from an org also removes it from all of the org teams.
+func ResourceOrgMember() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the membership of a user in an org. Destroying it also removes the user from " +
+			"every team of the org, including the memberships managed by 'mirantis-msr-connect_team_member' " +
+			"and 'mirantis-msr-connect_team' resources, which then plan to add the user again. Make those " +
+			"resources depend on this one so they are destroyed first.",
+		CreateContext: resourceOrgMemberCreate,
+		ReadContext:   resourceOrgMemberRead,
+		UpdateContext: resourceOrgMemberUpdate,
+		DeleteContext: resourceOrgMemberDelete,
+		CustomizeDiff: minMSRVersion("mirantis-msr-connect_org_member"),
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name or ID of the org.",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name or ID of the user.",
+			},
+			"is_admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceOrgMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	org := d.Get("org").(string)
+	user := d.Get("user").(string)
+	if _, err := c.AddOrgMember(ctx, org, user, d.Get("is_admin").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", org, user))
+
+	return resourceOrgMemberRead(ctx, d, m)
+}
+
+func resourceOrgMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	parts, err := splitCompositeID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := c.ReadOrgMember(ctx, parts[0], parts[1])
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"org":      parts[0],
+		"user":     parts[1],
+		"is_admin": member.IsAdmin,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.Diagnostics{}
+}
+
+func resourceOrgMemberUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if d.HasChange("is_admin") {
+		if _, err := c.AddOrgMember(ctx, d.Get("org").(string), d.Get("user").(string), d.Get("is_admin").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceOrgMemberRead(ctx, d, m)
+}
+
+func resourceOrgMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if err := c.DeleteOrgMember(ctx, d.Get("org").(string), d.Get("user").(string)); err != nil && !errors.Is(err, client.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,235 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourcePromotionPolicy for managing MSR repository promotion policies
+func ResourcePromotionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePromotionPolicyCreate,
+		ReadContext:   resourcePromotionPolicyRead,
+		UpdateContext: resourcePromotionPolicyUpdate,
+		DeleteContext: resourcePromotionPolicyDelete,
+		CustomizeDiff: minMSRVersion("mirantis-msr-connect_promotion_policy"),
+		Schema: map[string]*schema.Schema{
+			"org_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repo_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Repository tags are promoted to, in the 'namespace/repo' form.",
+			},
+			"tag_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%n",
+				Description: "Template for the name of the promoted tag, '%n' being the source tag name.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"rule": policyRuleSchema(),
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// policyRuleSchema is the rule block shared by the repository policy resources
+func policyRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Conditions a tag has to satisfy, all of them have to match.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(client.PolicyRuleFields, false),
+				},
+				"operator": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(client.PolicyRuleOperators, false),
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandPolicyRules(in []interface{}) []client.PolicyRule {
+	rules := make([]client.PolicyRule, 0, len(in))
+	for _, r := range in {
+		rule := r.(map[string]interface{})
+		values := []string{}
+		for _, v := range rule["values"].([]interface{}) {
+			values = append(values, v.(string))
+		}
+		rules = append(rules, client.PolicyRule{
+			Field:    rule["field"].(string),
+			Operator: rule["operator"].(string),
+			Values:   values,
+		})
+	}
+	return rules
+}
+
+func flattenPolicyRules(rules []client.PolicyRule) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, map[string]interface{}{
+			"field":    r.Field,
+			"operator": r.Operator,
+			"values":   r.Values,
+		})
+	}
+	return out
+}
+
+func promotionPolicyFromResourceData(d *schema.ResourceData) client.PromotionPolicy {
+	return client.PromotionPolicy{
+		Enabled:          d.Get("enabled").(bool),
+		Rules:            expandPolicyRules(d.Get("rule").([]interface{})),
+		TagTemplate:      d.Get("tag_template").(string),
+		TargetRepository: d.Get("target_repository").(string),
+	}
+}
+
+func resourcePromotionPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+	p, err := c.CreatePromotionPolicy(ctx, repoName, promotionPolicyFromResourceData(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", repoName, p.ID))
+
+	return resourcePromotionPolicyRead(ctx, d, m)
+}
+
+func resourcePromotionPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	parts, err := splitCompositeID(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	repoName := fmt.Sprintf("%s/%s", parts[0], parts[1])
+
+	p, err := c.ReadPromotionPolicy(ctx, repoName, parts[2])
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("org_name", parts[0]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("repo_name", parts[1]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("policy_id", p.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("target_repository", p.TargetRepository); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tag_template", p.TagTemplate); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", p.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rule", flattenPolicyRules(p.Rules)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{}
+}
+
+func resourcePromotionPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if d.HasChanges("target_repository", "tag_template", "enabled", "rule") {
+		repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+		if _, err := c.UpdatePromotionPolicy(ctx, repoName, d.Get("policy_id").(string), promotionPolicyFromResourceData(d)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePromotionPolicyRead(ctx, d, m)
+}
+
+func resourcePromotionPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+	if err := c.DeletePromotionPolicy(ctx, repoName, d.Get("policy_id").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
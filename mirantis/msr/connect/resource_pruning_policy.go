@@ -0,0 +1,165 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourcePruningPolicy for managing MSR repository tag pruning policies
+func ResourcePruningPolicy() *schema.Resource {
+	rule := policyRuleSchema()
+	rule.Optional = false
+	rule.Required = true
+	rule.MinItems = 1
+
+	return &schema.Resource{
+		CreateContext: resourcePruningPolicyCreate,
+		ReadContext:   resourcePruningPolicyRead,
+		UpdateContext: resourcePruningPolicyUpdate,
+		DeleteContext: resourcePruningPolicyDelete,
+		CustomizeDiff: minMSRVersion("mirantis-msr-connect_pruning_policy"),
+		Schema: map[string]*schema.Schema{
+			"org_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repo_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"apply_to_existing_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prune the tags already in the repository when the policy is created or updated.",
+			},
+			"rule": rule,
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourcePruningPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+	policy := client.PruningPolicy{
+		Enabled: d.Get("enabled").(bool),
+		Rules:   expandPolicyRules(d.Get("rule").([]interface{})),
+	}
+	p, err := c.CreatePruningPolicy(ctx, repoName, policy, d.Get("apply_to_existing_tags").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", repoName, p.ID))
+
+	return resourcePruningPolicyRead(ctx, d, m)
+}
+
+func resourcePruningPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	parts, err := splitCompositeID(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	repoName := fmt.Sprintf("%s/%s", parts[0], parts[1])
+
+	p, err := c.ReadPruningPolicy(ctx, repoName, parts[2])
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"org_name":  parts[0],
+		"repo_name": parts[1],
+		"policy_id": p.ID,
+		"enabled":   p.Enabled,
+		"rule":      flattenPolicyRules(p.Rules),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.Diagnostics{}
+}
+
+func resourcePruningPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if d.HasChanges("enabled", "rule") {
+		repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+		policy := client.PruningPolicy{
+			Enabled: d.Get("enabled").(bool),
+			Rules:   expandPolicyRules(d.Get("rule").([]interface{})),
+		}
+		if _, err := c.UpdatePruningPolicy(ctx, repoName, d.Get("policy_id").(string), policy, d.Get("apply_to_existing_tags").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePruningPolicyRead(ctx, d, m)
+}
+
+func resourcePruningPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	repoName := fmt.Sprintf("%s/%s", d.Get("org_name").(string), d.Get("repo_name").(string))
+	if err := c.DeletePruningPolicy(ctx, repoName, d.Get("policy_id").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
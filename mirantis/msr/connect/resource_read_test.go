@@ -0,0 +1,124 @@
+package connect_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	connect "github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// readResource reads a resource with the given ID and state into a new ResourceData
+func readResource(t *testing.T, r *schema.Resource, id string, state map[string]interface{}, c client.Client) *schema.ResourceData {
+	t.Helper()
+	d := r.TestResourceData()
+	d.SetId(id)
+	for k, v := range state {
+		if err := d.Set(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("expected no error, got (%v)", diags)
+	}
+	return d
+}
+
+// expectState checks the attributes of d
+func expectState(t *testing.T, d *schema.ResourceData, expected map[string]interface{}) {
+	t.Helper()
+	for k, v := range expected {
+		if got := d.Get(k); !reflect.DeepEqual(v, got) {
+			t.Errorf("%s: expected (%v), got (%v)", k, v, got)
+		}
+	}
+}
+
+func TestUserRead(t *testing.T) {
+	c := apiServer(t, map[string]string{
+		"/enzi/v0/accounts/user-id": `{"name":"jdoe","id":"user-id","fullName":"Jane Doe","isActive":false,"isAdmin":true}`,
+	})
+	stale := map[string]interface{}{"name": "jdoe", "full_name": "J. Doe", "is_active": true, "is_admin": false, "password": "secret"}
+
+	d := readResource(t, connect.ResourceUser(), "user-id", stale, c)
+	// The password isn't returned by MSR, so it is kept
+	expectState(t, d, map[string]interface{}{"full_name": "Jane Doe", "is_active": false, "is_admin": true, "password": "secret"})
+	if d.Id() != "user-id" {
+		t.Errorf("expected (%s), got (%s)", "user-id", d.Id())
+	}
+
+	if d := readResource(t, connect.ResourceUser(), "deleted-id", stale, c); d.Id() != "" {
+		t.Errorf("expected the deleted user to be removed from the state, got (%s)", d.Id())
+	}
+}
+
+func TestOrgRead(t *testing.T) {
+	c := apiServer(t, map[string]string{
+		"/enzi/v0/accounts/org-id": `{"name":"dev","id":"org-id","isOrg":true}`,
+	})
+
+	d := readResource(t, connect.ResourceOrg(), "org-id", map[string]interface{}{"name": "stale"}, c)
+	expectState(t, d, map[string]interface{}{"name": "dev"})
+	if d.Id() != "org-id" {
+		t.Errorf("expected (%s), got (%s)", "org-id", d.Id())
+	}
+
+	if d := readResource(t, connect.ResourceOrg(), "deleted-id", map[string]interface{}{"name": "gone"}, c); d.Id() != "" {
+		t.Errorf("expected the deleted org to be removed from the state, got (%s)", d.Id())
+	}
+}
+
+func TestTeamRead(t *testing.T) {
+	c := apiServer(t, map[string]string{
+		"/enzi/v0/accounts/org-id/teams/team-id": `{"name":"ops","id":"team-id","orgID":"org-id","description":"On call"}`,
+		"/enzi/v0/accounts/org-id/teams/team-id/members": `{"members":[
+			{"member":{"name":"jdoe","id":"user-1"}},
+			{"member":{"name":"asmith","id":"user-3"}}
+		]}`,
+	})
+	// user-2 left the team and user-3 joined it outside of Terraform
+	stale := map[string]interface{}{
+		"name":        "oncall",
+		"org_id":      "org-id",
+		"description": "",
+		"user_ids":    []interface{}{"user-1", "user-2"},
+	}
+
+	d := readResource(t, connect.ResourceTeam(), "team-id", stale, c)
+	expectState(t, d, map[string]interface{}{
+		"name":        "ops",
+		"description": "On call",
+		"user_ids":    []interface{}{"user-1", "user-3"},
+		"ldap_sync":   []interface{}{},
+	})
+
+	if d := readResource(t, connect.ResourceTeam(), "deleted-id", stale, c); d.Id() != "" {
+		t.Errorf("expected the deleted team to be removed from the state, got (%s)", d.Id())
+	}
+}
+
+func TestRepoRead(t *testing.T) {
+	c := apiServer(t, map[string]string{
+		"/api/v0/repositories/dev/app": `{"id":"repo-id","name":"app","namespace":"dev","scanOnPush":true,"tagLimit":10,"visibility":"public"}`,
+	})
+	stale := map[string]interface{}{
+		"name":         "app",
+		"org_name":     "dev",
+		"scan_on_push": false,
+		"tag_limit":    0,
+		"visibility":   client.RepoVisibilityPrivate,
+	}
+
+	d := readResource(t, connect.ResourceRepo(), "dev/app", stale, c)
+	expectState(t, d, map[string]interface{}{
+		"scan_on_push": true,
+		"tag_limit":    10,
+		"visibility":   client.RepoVisibilityPublic,
+	})
+
+	if d := readResource(t, connect.ResourceRepo(), "dev/deleted", stale, c); d.Id() != "" {
+		t.Errorf("expected the deleted repo to be removed from the state, got (%s)", d.Id())
+	}
+}
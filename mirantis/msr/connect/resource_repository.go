@@ -2,12 +2,15 @@ package connect
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ResourceRepo for managing MSR repository
@@ -19,18 +22,34 @@ func ResourceRepo() *schema.Resource {
 		DeleteContext: resourceRepoDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Repositories can't be renamed, a new name replaces the repository.",
 			},
 			"org_name": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      client.RepoVisibilityPrivate,
+				ValidateFunc: validation.StringInSlice(client.RepoVisibilities, false),
 			},
 			"scan_on_push": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"tag_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Maximum number of tags kept in the repository, the oldest being pruned first. 0 means no limit.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"last_updated": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -38,7 +57,7 @@ func ResourceRepo() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceRepoImport,
 		},
 	}
 }
@@ -52,6 +71,8 @@ func resourceRepoCreate(ctx context.Context, d *schema.ResourceData, m interface
 	repo := client.CreateRepo{
 		Name:       d.Get("name").(string),
 		ScanOnPush: d.Get("scan_on_push").(bool),
+		TagLimit:   d.Get("tag_limit").(int),
+		Visibility: d.Get("visibility").(string),
 	}
 	orgName := d.Get("org_name").(string)
 	_, err := c.CreateRepo(ctx, orgName, repo)
@@ -63,7 +84,39 @@ func resourceRepoCreate(ctx context.Context, d *schema.ResourceData, m interface
 	}
 	d.SetId(fmt.Sprintf("%s/%s", orgName, repo.Name))
 
-	return diag.Diagnostics{}
+	return resourceRepoRead(ctx, d, m)
+}
+
+// resourceRepoImport imports a repo by its 'namespace/repo' ID, the namespace being either a name or an ID
+func resourceRepoImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c, ok := m.(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	parts, err := splitCompositeID(d.Id(), 2)
+	if err != nil || strings.Contains(parts[1], "/") {
+		return nil, fmt.Errorf("%w: expected 'namespace/repo', got '%s'", client.ErrIDHasNoRepoName, d.Id())
+	}
+	// Repositories are only addressed by the namespace name
+	namespace, err := c.ReadAccount(ctx, parts[0])
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.ReadRepo(ctx, fmt.Sprintf("%s/%s", namespace.Name, parts[1]))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("org_name", r.Namespace); err != nil {
+		return nil, err
+	}
+	if err := d.Set("name", r.Name); err != nil {
+		return nil, err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", r.Namespace, r.Name))
+
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceRepoRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -72,14 +125,29 @@ func resourceRepoRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		return diag.Errorf("unable to cast meta interface to MSR Client")
 	}
 
-	_, err := c.ReadRepo(ctx, d.State().ID)
+	r, err := c.ReadRepo(ctx, d.Id())
 	if err != nil {
 		// If the repo doesn't exist we should gracefully handle it
-		d.SetId("")
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
 		return diag.FromErr(err)
 	}
 
-	d.SetId(d.State().ID)
+	values := map[string]interface{}{
+		"name":         r.Name,
+		"org_name":     r.Namespace,
+		"scan_on_push": r.ScanOnPush,
+		"tag_limit":    r.TagLimit,
+		"visibility":   r.Visibility,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(fmt.Sprintf("%s/%s", r.Namespace, r.Name))
 
 	return diag.Diagnostics{}
 }
@@ -93,10 +161,11 @@ func resourceRepoUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 	repo := client.UpdateRepo{
 		ScanOnPush: d.Get("scan_on_push").(bool),
-		Visibility: "private",
+		TagLimit:   d.Get("tag_limit").(int),
+		Visibility: d.Get("visibility").(string),
 	}
 
-	if d.HasChange("scan_on_push") {
+	if d.HasChanges("scan_on_push", "tag_limit", "visibility") {
 		if _, err := c.UpdateRepo(ctx, d.State().ID, repo); err != nil {
 			return diag.FromErr(err)
 		}
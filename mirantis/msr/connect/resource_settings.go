@@ -0,0 +1,220 @@
+package connect
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const settingsID = "msr-settings"
+
+// ResourceSettings for managing the MSR system settings.
+// It is a singleton: only the settings present in the configuration are managed,
+// the others are reported as computed and left untouched.
+// The garbage collection schedule is the onlinegc cron, managed by ResourceCron.
+func ResourceSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the MSR system settings. The garbage collection schedule isn't one of them, " +
+			"it is managed by a 'mirantis-msr-connect_cron' resource with the 'onlinegc' action.",
+		CreateContext: resourceSettingsCreate,
+		ReadContext:   resourceSettingsRead,
+		UpdateContext: resourceSettingsUpdate,
+		DeleteContext: resourceSettingsDelete,
+		CustomizeDiff: minMSRVersion("mirantis-msr-connect_settings"),
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Load balancer domain name MSR is reached through.",
+			},
+			"web_tls_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"web_tls_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"web_tls_cert"},
+			},
+			"web_tls_ca": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"scanning_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"scanning_sync_online": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Keep the vulnerability database up to date from the internet.",
+			},
+			"create_repository_on_push": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(client.LogLevels, false),
+			},
+			"msr_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// settingsFromResourceData builds the settings to send, on create the configured ones
+// and on update the changed ones.
+func settingsFromResourceData(d *schema.ResourceData, isNew bool) client.Settings {
+	wanted := func(key string) bool {
+		if isNew {
+			return isConfigured(d, key)
+		}
+		return d.HasChange(key)
+	}
+	str := func(key string) *string {
+		v := d.Get(key).(string)
+		return &v
+	}
+	boolean := func(key string) *bool {
+		v := d.Get(key).(bool)
+		return &v
+	}
+
+	s := client.Settings{}
+	if wanted("domain") {
+		s.DTRHost = str("domain")
+	}
+	if wanted("web_tls_cert") || wanted("web_tls_key") {
+		s.WebTLSCert = str("web_tls_cert")
+		s.WebTLSKey = str("web_tls_key")
+	}
+	if wanted("web_tls_ca") {
+		s.WebTLSCA = str("web_tls_ca")
+	}
+	if wanted("scanning_enabled") {
+		s.ScanningEnabled = boolean("scanning_enabled")
+	}
+	if wanted("scanning_sync_online") {
+		s.ScanningSyncOnline = boolean("scanning_sync_online")
+	}
+	if wanted("create_repository_on_push") {
+		s.CreateRepositoryOnPush = boolean("create_repository_on_push")
+	}
+	if wanted("log_level") {
+		s.LogLevel = str("log_level")
+	}
+	return s
+}
+
+func applySettings(ctx context.Context, d *schema.ResourceData, c client.Client, isNew bool) diag.Diagnostics {
+	if diags := requireMSRVersion(c, "mirantis-msr-connect_settings"); diags.HasError() {
+		return diags
+	}
+
+	if s := settingsFromResourceData(d, isNew); (s != client.Settings{}) {
+		if _, err := c.UpdateSettings(ctx, s); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if diags := applySettings(ctx, d, c, true); diags.HasError() {
+		return diags
+	}
+	d.SetId(settingsID)
+
+	return resourceSettingsRead(ctx, d, m)
+}
+
+func resourceSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	s, err := c.ReadSettings(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	version := c.Version.String()
+	if !c.Version.IsKnown() {
+		if version, err = c.GetMSRVersion(ctx); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// web_tls_key is write only in MSR, so it is kept as configured
+	values := map[string]interface{}{
+		"domain":                    s.DTRHost,
+		"web_tls_cert":              s.WebTLSCert,
+		"web_tls_ca":                s.WebTLSCA,
+		"scanning_enabled":          s.ScanningEnabled,
+		"scanning_sync_online":      s.ScanningSyncOnline,
+		"create_repository_on_push": s.CreateRepositoryOnPush,
+		"log_level":                 s.LogLevel,
+		"msr_version":               version,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(settingsID)
+
+	return diag.Diagnostics{}
+}
+
+func resourceSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if diags := applySettings(ctx, d, c, false); diags.HasError() {
+		return diags
+	}
+
+	return resourceSettingsRead(ctx, d, m)
+}
+
+func resourceSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// MSR settings can't be deleted, they are only removed from the Terraform state
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
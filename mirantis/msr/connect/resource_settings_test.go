@@ -0,0 +1,35 @@
+package connect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	connect "github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/connect"
+)
+
+func TestSettingsReadVersion(t *testing.T) {
+	c := apiServer(t, map[string]string{
+		"/api/v0/meta/settings": `{"dtrHost":"msr.example.com","logLevel":"info"}`,
+		"/api/v0/admin/version": `{"version":"2.9.3"}`,
+	})
+	r := connect.ResourceSettings()
+
+	// The provider couldn't load the version, so it is read from MSR
+	d := r.TestResourceData()
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("expected no error, got (%v)", diags)
+	}
+	if d.Get("msr_version") != "2.9.3" || d.Get("domain") != "msr.example.com" {
+		t.Errorf("unexpected state, msr_version (%v), domain (%v)", d.Get("msr_version"), d.Get("domain"))
+	}
+
+	c.Version = client.Version{Major: 3, Minor: 1, Patch: 2}
+	d = r.TestResourceData()
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("expected no error, got (%v)", diags)
+	}
+	if d.Get("msr_version") != "3.1.2" {
+		t.Errorf("expected (%s), got (%v)", c.Version, d.Get("msr_version"))
+	}
+}
@@ -0,0 +1,385 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const storageID = "msr-storage"
+
+var storageBackends = []string{"filesystem", "s3", "azure", "swift", "nfs"}
+
+// ResourceStorage for managing the MSR registry storage backend.
+// It is a singleton, deleting it only removes it from the Terraform state.
+func ResourceStorage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStorageCreate,
+		ReadContext:   resourceStorageRead,
+		UpdateContext: resourceStorageUpdate,
+		DeleteContext: resourceStorageDelete,
+		CustomizeDiff: customdiff.Sequence(
+			minMSRVersion("mirantis-msr-connect_storage"),
+			resourceStorageCustomizeDiff,
+		),
+		Schema: map[string]*schema.Schema{
+			"filesystem": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: storageBackends,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"root_directory": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"s3": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: storageBackends,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"access_key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"secret_key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"region_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"root_directory": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encrypt": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"secure": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"v4auth": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"azure": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: storageBackends,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"account_key": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"container": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"realm": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"swift": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: storageBackends,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"container": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tenant": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"insecure_skip_verify": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"nfs": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: storageBackends,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "NFS export, e.g. 'nfs://nfs.example.com/msr'.",
+						},
+					},
+				},
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandStorageConfig(get func(string) interface{}) client.StorageConfig {
+	s := client.StorageConfig{}
+	if b := block(get, "filesystem"); b != nil {
+		s.Filesystem = &client.FilesystemStorage{
+			RootDirectory: b["root_directory"].(string),
+		}
+	} else if len(get("filesystem").([]interface{})) > 0 {
+		// An empty filesystem block selects the default local storage
+		s.Filesystem = &client.FilesystemStorage{}
+	}
+	if b := block(get, "s3"); b != nil {
+		s.S3 = &client.S3Storage{
+			Region:         b["region"].(string),
+			Bucket:         b["bucket"].(string),
+			AccessKey:      b["access_key"].(string),
+			SecretKey:      b["secret_key"].(string),
+			RegionEndpoint: b["region_endpoint"].(string),
+			RootDirectory:  b["root_directory"].(string),
+			Encrypt:        b["encrypt"].(bool),
+			Secure:         b["secure"].(bool),
+			V4Auth:         b["v4auth"].(bool),
+		}
+	}
+	if b := block(get, "azure"); b != nil {
+		s.Azure = &client.AzureStorage{
+			AccountName: b["account_name"].(string),
+			AccountKey:  b["account_key"].(string),
+			Container:   b["container"].(string),
+			Realm:       b["realm"].(string),
+		}
+	}
+	if b := block(get, "swift"); b != nil {
+		s.Swift = &client.SwiftStorage{
+			AuthURL:            b["auth_url"].(string),
+			Username:           b["username"].(string),
+			Password:           b["password"].(string),
+			Container:          b["container"].(string),
+			Region:             b["region"].(string),
+			Tenant:             b["tenant"].(string),
+			TenantID:           b["tenant_id"].(string),
+			Domain:             b["domain"].(string),
+			Prefix:             b["prefix"].(string),
+			InsecureSkipVerify: b["insecure_skip_verify"].(bool),
+		}
+	}
+	if b := block(get, "nfs"); b != nil {
+		s.NFS = &client.NFSStorage{
+			URL: b["url"].(string),
+		}
+	}
+	return s
+}
+
+func resourceStorageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// Values coming from other resources are only known at apply time, the
+	// blocks and every field inside them have to be known to be validated
+	for _, k := range storageBackends {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+		for field := range block(d.Get, k) {
+			if !d.NewValueKnown(fmt.Sprintf("%s.0.%s", k, field)) {
+				return nil
+			}
+		}
+	}
+	return expandStorageConfig(d.Get).Validate()
+}
+
+func resourceStorageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if err := c.UpdateStorage(ctx, expandStorageConfig(d.Get)); err != nil {
+		return diagFromAPIError("Unable to configure the MSR storage backend", err)
+	}
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(storageID)
+
+	return resourceStorageRead(ctx, d, m)
+}
+
+func resourceStorageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	s, err := c.ReadStorage(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Credentials are redacted by MSR, so they are kept as configured
+	configured := func(key, attr string) interface{} {
+		if b := block(d.Get, key); b != nil {
+			return b[attr]
+		}
+		return ""
+	}
+	values := map[string]interface{}{}
+	for _, k := range storageBackends {
+		values[k] = []interface{}{}
+	}
+	if s.Filesystem != nil {
+		values["filesystem"] = []interface{}{map[string]interface{}{
+			"root_directory": s.Filesystem.RootDirectory,
+		}}
+	}
+	if s.S3 != nil {
+		values["s3"] = []interface{}{map[string]interface{}{
+			"region":          s.S3.Region,
+			"bucket":          s.S3.Bucket,
+			"access_key":      configured("s3", "access_key"),
+			"secret_key":      configured("s3", "secret_key"),
+			"region_endpoint": s.S3.RegionEndpoint,
+			"root_directory":  s.S3.RootDirectory,
+			"encrypt":         s.S3.Encrypt,
+			"secure":          s.S3.Secure,
+			"v4auth":          s.S3.V4Auth,
+		}}
+	}
+	if s.Azure != nil {
+		values["azure"] = []interface{}{map[string]interface{}{
+			"account_name": s.Azure.AccountName,
+			"account_key":  configured("azure", "account_key"),
+			"container":    s.Azure.Container,
+			"realm":        s.Azure.Realm,
+		}}
+	}
+	if s.Swift != nil {
+		values["swift"] = []interface{}{map[string]interface{}{
+			"auth_url":             s.Swift.AuthURL,
+			"username":             s.Swift.Username,
+			"password":             configured("swift", "password"),
+			"container":            s.Swift.Container,
+			"region":               s.Swift.Region,
+			"tenant":               s.Swift.Tenant,
+			"tenant_id":            s.Swift.TenantID,
+			"domain":               s.Swift.Domain,
+			"prefix":               s.Swift.Prefix,
+			"insecure_skip_verify": s.Swift.InsecureSkipVerify,
+		}}
+	}
+	if s.NFS != nil {
+		values["nfs"] = []interface{}{map[string]interface{}{
+			"url": s.NFS.URL,
+		}}
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(storageID)
+
+	return diag.Diagnostics{}
+}
+
+func resourceStorageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if d.HasChanges(storageBackends...) {
+		if err := c.UpdateStorage(ctx, expandStorageConfig(d.Get)); err != nil {
+			return diagFromAPIError("Unable to configure the MSR storage backend", err)
+		}
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceStorageRead(ctx, d, m)
+}
+
+func resourceStorageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// MSR always needs a storage backend, so it is only removed from the Terraform state
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
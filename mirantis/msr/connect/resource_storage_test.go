@@ -0,0 +1,40 @@
+package connect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	connect "github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// unknownValue is how Terraform marks a value only known at apply time
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestStorageCustomizeDiff(t *testing.T) {
+	testCases := map[string]struct {
+		s3        map[string]interface{}
+		expectErr bool
+	}{
+		"valid": {
+			s3: map[string]interface{}{"region": "us-east-1", "bucket": "registry", "access_key": "AKIA", "secret_key": "secret"},
+		},
+		"access key without secret key": {
+			s3:        map[string]interface{}{"region": "us-east-1", "bucket": "registry", "access_key": "AKIA"},
+			expectErr: true,
+		},
+		"unknown secret key": {
+			s3: map[string]interface{}{"region": "us-east-1", "bucket": "registry", "access_key": "AKIA", "secret_key": unknownValue},
+		},
+	}
+	for name, tc := range testCases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"s3": []interface{}{tc.s3},
+		})
+		_, err := connect.ResourceStorage().Diff(context.Background(), nil, config, client.Client{})
+		if (err != nil) != tc.expectErr {
+			t.Errorf("%s: expected error (%v), got (%v)", name, tc.expectErr, err)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package connect
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
@@ -24,18 +26,61 @@ func ResourceTeam() *schema.Resource {
 			"org_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"user_ids": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"ldap_sync"},
+				Description:   "Members of the team, when not set they are only reported and left to team_member resources or the LDAP sync.",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"ldap_sync": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"user_ids"},
+				Description:   "Sync the team members from LDAP, either from a group or from a search.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_dn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"ldap_sync.0.group_dn", "ldap_sync.0.search_base_dn"},
+						},
+						"group_member_attribute": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "member",
+						},
+						"search_base_dn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"search_filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"search_subtree": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"sync_trigger": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Arbitrary value, changing it triggers an on-demand LDAP sync.",
+						},
+					},
+				},
+			},
 			"last_updated": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -43,7 +88,7 @@ func ResourceTeam() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTeamImport,
 		},
 	}
 }
@@ -67,16 +112,50 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, m interface
 	}
 	d.SetId(t.ID)
 
-	for _, id := range d.Get("user_ids").([]interface{}) {
-		u := client.ResponseAccount{
-			ID: id.(string),
+	if userIDs := teamUserIDs(d); len(userIDs) > 0 {
+		if err := c.UpdateTeamUsers(ctx, d.Get("org_id").(string), t.ID, userIDs); err != nil {
+			return diag.FromErr(err)
 		}
-		if err := c.AddUserToTeam(ctx, d.Get("org_id").(string), t.ID, u); err != nil {
+	}
+
+	if _, ok := d.GetOk("ldap_sync"); ok {
+		if err := updateTeamMemberSync(ctx, c, d); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	return diag.Diagnostics{}
+	return resourceTeamRead(ctx, d, m)
+}
+
+// resourceTeamImport imports a team by its 'org/team' ID, both parts being either names or IDs
+func resourceTeamImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c, ok := m.(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	parts, err := splitCompositeID(d.Id(), 2)
+	if err != nil {
+		return nil, err
+	}
+	org, err := c.ReadAccount(ctx, parts[0])
+	if err != nil {
+		return nil, err
+	}
+	t, err := c.ReadTeam(ctx, org.ID, parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("org_id", org.ID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("name", t.Name); err != nil {
+		return nil, err
+	}
+	d.SetId(t.ID)
+
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -85,13 +164,40 @@ func resourceTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		return diag.Errorf("unable to cast meta interface to MSR Client")
 	}
 
-	t, err := c.ReadTeam(ctx, d.Get("org_id").(string), d.State().ID)
+	orgID := d.Get("org_id").(string)
+	t, err := c.ReadTeam(ctx, orgID, d.Id())
+	if err != nil {
+		// If the team doesn't exist we should gracefully handle it
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"name":        t.Name,
+		"description": t.Description,
+	}
+
+	// Teams which never had a sync config don't have one to read
+	cfg, err := c.ReadTeamMemberSync(ctx, orgID, t.ID)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	values["ldap_sync"] = flattenMemberSyncConfig(d, cfg)
+
+	members, err := c.GetTeamUsers(ctx, orgID, t.ID)
 	if err != nil {
-		// If the user doesn't exist we should gracefully handle it
-		d.SetId("")
 		return diag.FromErr(err)
 	}
+	values["user_ids"] = refreshTeamUserIDs(teamUserIDs(d), members)
 
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	d.SetId(t.ID)
 
 	return diag.Diagnostics{}
@@ -105,19 +211,25 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	}
 	team := client.Team{
 		ID:          d.State().ID,
+		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
 	}
 	orgID := d.Get("org_id").(string)
 
-	if d.HasChange("description") {
+	if d.HasChanges("name", "description") {
 		if _, err := c.UpdateTeam(ctx, orgID, team); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	if d.HasChange("ldap_sync") {
+		if err := updateTeamMemberSync(ctx, c, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("user_ids") {
-		_, n := d.GetChange("user_ids")
-		if err := c.UpdateTeamUsers(ctx, orgID, team.ID, n.([]interface{})); err != nil {
+		if err := c.UpdateTeamUsers(ctx, orgID, team.ID, teamUserIDs(d)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -144,3 +256,100 @@ func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, m interface
 
 	return diag.Diagnostics{}
 }
+
+func teamUserIDs(d *schema.ResourceData) []string {
+	ids := []string{}
+	for _, id := range d.Get("user_ids").([]interface{}) {
+		ids = append(ids, id.(string))
+	}
+	return ids
+}
+
+// refreshTeamUserIDs keeps the known user IDs in their order while they are still
+// members, followed by the members added outside of Terraform
+func refreshTeamUserIDs(known []string, members []client.ResponseTeamMember) []string {
+	byKey := map[string]string{}
+	for _, m := range members {
+		byKey[m.Member.ID] = m.Member.ID
+		byKey[m.Member.Name] = m.Member.ID
+	}
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, k := range known {
+		if id, ok := byKey[k]; ok && !seen[id] {
+			ids = append(ids, k)
+			seen[id] = true
+		}
+	}
+	for _, m := range members {
+		if !seen[m.Member.ID] {
+			ids = append(ids, m.Member.ID)
+			seen[m.Member.ID] = true
+		}
+	}
+	return ids
+}
+
+// flattenMemberSyncConfig turns an enabled sync config into the ldap_sync block,
+// the sync_trigger only lives in the Terraform state
+func flattenMemberSyncConfig(d *schema.ResourceData, cfg client.MemberSyncConfig) []interface{} {
+	if !cfg.EnableSync {
+		return []interface{}{}
+	}
+	trigger := ""
+	if b := block(d.Get, "ldap_sync"); b != nil {
+		trigger = b["sync_trigger"].(string)
+	}
+	groupDN := ""
+	if cfg.SelectGroupMembers {
+		groupDN = cfg.GroupDN
+	}
+	searchBaseDN := ""
+	if !cfg.SelectGroupMembers {
+		searchBaseDN = cfg.SearchBaseDN
+	}
+	return []interface{}{map[string]interface{}{
+		"group_dn":               groupDN,
+		"group_member_attribute": cfg.GroupMemberAttr,
+		"search_base_dn":         searchBaseDN,
+		"search_filter":          cfg.SearchFilter,
+		"search_subtree":         cfg.SearchScopeSubtree,
+		"sync_trigger":           trigger,
+	}}
+}
+
+// memberSyncConfigFromResourceData builds the sync config from the ldap_sync block,
+// a missing block disables the sync
+func memberSyncConfigFromResourceData(d *schema.ResourceData) client.MemberSyncConfig {
+	blocks := d.Get("ldap_sync").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return client.MemberSyncConfig{}
+	}
+	b := blocks[0].(map[string]interface{})
+	groupDN := b["group_dn"].(string)
+
+	return client.MemberSyncConfig{
+		EnableSync:         true,
+		SelectGroupMembers: groupDN != "",
+		GroupDN:            groupDN,
+		GroupMemberAttr:    b["group_member_attribute"].(string),
+		SearchBaseDN:       b["search_base_dn"].(string),
+		SearchFilter:       b["search_filter"].(string),
+		SearchScopeSubtree: b["search_subtree"].(bool),
+	}
+}
+
+// updateTeamMemberSync stores the team sync config and, while the sync is enabled,
+// syncs the members right away instead of waiting for the next scheduled sync
+func updateTeamMemberSync(ctx context.Context, c client.Client, d *schema.ResourceData) error {
+	cfg, err := c.UpdateTeamMemberSync(ctx, d.Get("org_id").(string), d.Id(), memberSyncConfigFromResourceData(d))
+	if err != nil {
+		return err
+	}
+	if !cfg.EnableSync {
+		return nil
+	}
+	_, err = c.SyncLDAP(ctx)
+
+	return err
+}
@@ -0,0 +1,105 @@
+package connect
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMemberSyncConfigFromResourceData(t *testing.T) {
+	testCases := map[string]struct {
+		raw      map[string]interface{}
+		expected client.MemberSyncConfig
+	}{
+		"no ldap_sync": {
+			raw:      map[string]interface{}{"name": "devs", "org_id": "org-id"},
+			expected: client.MemberSyncConfig{},
+		},
+		"group": {
+			raw: map[string]interface{}{
+				"name":   "devs",
+				"org_id": "org-id",
+				"ldap_sync": []interface{}{map[string]interface{}{
+					"group_dn": "cn=devs,ou=groups,dc=example,dc=com",
+				}},
+			},
+			expected: client.MemberSyncConfig{
+				EnableSync:         true,
+				SelectGroupMembers: true,
+				GroupDN:            "cn=devs,ou=groups,dc=example,dc=com",
+				GroupMemberAttr:    "member",
+			},
+		},
+		"search": {
+			raw: map[string]interface{}{
+				"name":   "devs",
+				"org_id": "org-id",
+				"ldap_sync": []interface{}{map[string]interface{}{
+					"search_base_dn": "ou=people,dc=example,dc=com",
+					"search_filter":  "(department=dev)",
+					"search_subtree": true,
+				}},
+			},
+			expected: client.MemberSyncConfig{
+				EnableSync:         true,
+				GroupMemberAttr:    "member",
+				SearchBaseDN:       "ou=people,dc=example,dc=com",
+				SearchFilter:       "(department=dev)",
+				SearchScopeSubtree: true,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		d := schema.TestResourceDataRaw(t, ResourceTeam().Schema, tc.raw)
+		if got := memberSyncConfigFromResourceData(d); !reflect.DeepEqual(tc.expected, got) {
+			t.Errorf("%s: expected (%+v), got (%+v)", name, tc.expected, got)
+		}
+	}
+}
+
+func TestFlattenMemberSyncConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceTeam().Schema, map[string]interface{}{
+		"name":   "devs",
+		"org_id": "org-id",
+		"ldap_sync": []interface{}{map[string]interface{}{
+			"group_dn":     "cn=devs,ou=groups,dc=example,dc=com",
+			"sync_trigger": "2022-06-01",
+		}},
+	})
+
+	if got := flattenMemberSyncConfig(d, client.MemberSyncConfig{}); len(got) != 0 {
+		t.Errorf("expected a disabled sync to have no block, got (%+v)", got)
+	}
+
+	// The search fields left over from a previous config aren't reported for a group sync
+	cfg := client.MemberSyncConfig{
+		EnableSync:         true,
+		SelectGroupMembers: true,
+		GroupDN:            "cn=devs,ou=groups,dc=example,dc=com",
+		GroupMemberAttr:    "member",
+		SearchBaseDN:       "ou=people,dc=example,dc=com",
+	}
+	expected := []interface{}{map[string]interface{}{
+		"group_dn":               "cn=devs,ou=groups,dc=example,dc=com",
+		"group_member_attribute": "member",
+		"search_base_dn":         "",
+		"search_filter":          "",
+		"search_subtree":         false,
+		"sync_trigger":           "2022-06-01",
+	}}
+	got := flattenMemberSyncConfig(d, cfg)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected (%+v), got (%+v)", expected, got)
+	}
+
+	// Flattening and expanding again gives back the same config, except the leftovers
+	if err := d.Set("ldap_sync", got); err != nil {
+		t.Fatal(err)
+	}
+	cfg.SearchBaseDN = ""
+	if roundTrip := memberSyncConfigFromResourceData(d); !reflect.DeepEqual(cfg, roundTrip) {
+		t.Errorf("expected (%+v), got (%+v)", cfg, roundTrip)
+	}
+}
@@ -2,11 +2,13 @@ package connect
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ResourceUser for managing MSR user
@@ -16,10 +18,13 @@ func ResourceUser() *schema.Resource {
 		ReadContext:   resourceUserRead,
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
+		CustomizeDiff: resourceUserCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Accounts can't be renamed, a new name replaces the user.",
 			},
 			"full_name": {
 				Type:     schema.TypeString,
@@ -41,9 +46,46 @@ func ResourceUser() *schema.Resource {
 				Computed: true,
 			},
 			"password": {
-				Type:     schema.TypeString,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Generated following password_policy when not set, changing either of them rotates the password in place.",
+			},
+			"password_policy": {
+				Type:     schema.TypeList,
 				Optional: true,
-				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      client.DefaultPasswordPolicy.Length,
+							ValidateFunc: validation.IntAtLeast(client.MinPasswordLength),
+						},
+						"lower": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"upper": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"digits": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"special": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
 			},
 		},
 		Importer: &schema.ResourceImporter{
@@ -60,7 +102,10 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	pass := d.Get("password").(string)
 	if pass == "" {
-		pass = client.GeneratePass()
+		var err error
+		if pass, err = client.GeneratePassword(passwordPolicy(d)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	user := client.CreateAccount{
@@ -84,7 +129,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	}
 	d.SetId(u.ID)
 
-	return diag.Diagnostics{}
+	return resourceUserRead(ctx, d, m)
 }
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -93,13 +138,28 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		return diag.Errorf("unable to cast meta interface to MSR Client")
 	}
 
-	u, err := c.ReadAccount(ctx, d.State().ID)
+	u, err := c.ReadAccount(ctx, d.Id())
 	if err != nil {
 		// If the user doesn't exist we should gracefully handle it
-		d.SetId("")
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
 		return diag.FromErr(err)
 	}
 
+	// The password is never returned by MSR, so it is kept as is
+	values := map[string]interface{}{
+		"name":      u.Name,
+		"full_name": u.FullName,
+		"is_active": u.IsActive,
+		"is_admin":  u.IsAdmin,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	d.SetId(u.ID)
 
 	return diag.Diagnostics{}
@@ -111,7 +171,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	if !ok {
 		return diag.Errorf("unable to cast meta interface to MSR Client")
 	}
-	if d.HasChange("msr_user") {
+	if d.HasChanges("full_name", "is_active", "is_admin") {
 		user := client.UpdateAccount{
 			FullName: d.Get("full_name").(string),
 			IsActive: d.Get("is_active").(bool),
@@ -124,9 +184,61 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 			return diag.FromErr(err)
 		}
 	}
+	// An unset password is computed, so only a newly configured one is a change
+	if d.HasChange("password") && d.Get("password").(string) != "" {
+		o, n := d.GetChange("password")
+		if err := c.ChangePassword(ctx, d.Id(), o.(string), n.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChange("password_policy") && !isConfigured(d, "password") {
+		// A generated password follows its policy, so a new policy generates a new password
+		o, _ := d.GetChange("password")
+		pass, err := client.GeneratePassword(passwordPolicy(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := c.ChangePassword(ctx, d.Id(), o.(string), pass); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("password", pass); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceUserRead(ctx, d, m)
 }
 
+// resourceUserCustomizeDiff plans a new generated password when its policy changes
+func resourceUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("password_policy") {
+		return nil
+	}
+	if config := d.GetRawConfig(); !config.IsNull() && !config.GetAttr("password").IsNull() {
+		return nil
+	}
+	return d.SetNewComputed("password")
+}
+
+// passwordPolicy returns the configured password policy, or the default one
+func passwordPolicy(d *schema.ResourceData) client.PasswordPolicy {
+	b := block(d.Get, "password_policy")
+	if b == nil {
+		return client.DefaultPasswordPolicy
+	}
+	return client.PasswordPolicy{
+		Length:  b["length"].(int),
+		Lower:   b["lower"].(bool),
+		Upper:   b["upper"].(bool),
+		Digits:  b["digits"].(bool),
+		Special: b["special"].(bool),
+	}
+}
+
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c, ok := m.(client.Client)
 
@@ -0,0 +1,53 @@
+package connect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	connect "github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUserPasswordPolicyChange(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "user-id",
+		Attributes: map[string]string{
+			"id":                        "user-id",
+			"name":                      "jdoe",
+			"is_active":                 "true",
+			"is_admin":                  "false",
+			"password":                  "generated",
+			"password_policy.#":         "1",
+			"password_policy.0.length":  "16",
+			"password_policy.0.lower":   "true",
+			"password_policy.0.upper":   "true",
+			"password_policy.0.digits":  "true",
+			"password_policy.0.special": "true",
+		},
+	}
+	policy := func(length int) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "jdoe",
+			"password_policy": []interface{}{map[string]interface{}{
+				"length": length,
+			}},
+		}
+	}
+
+	diff, err := connect.ResourceUser().Diff(context.Background(), state, terraform.NewResourceConfigRaw(policy(24)), client.Client{})
+	if err != nil {
+		t.Fatalf("expected (%v), got (%v)", nil, err)
+	}
+	if attr := diff.Attributes["password"]; attr == nil || !attr.NewComputed || attr.RequiresNew {
+		t.Errorf("expected the password to be regenerated in place, got (%+v)", attr)
+	}
+
+	diff, err = connect.ResourceUser().Diff(context.Background(), state, terraform.NewResourceConfigRaw(policy(16)), client.Client{})
+	if err != nil {
+		t.Fatalf("expected (%v), got (%v)", nil, err)
+	}
+	if diff != nil && diff.Attributes["password"] != nil {
+		t.Errorf("expected the password to be kept, got (%+v)", diff.Attributes["password"])
+	}
+}
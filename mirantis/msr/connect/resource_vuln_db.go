@@ -0,0 +1,174 @@
+package connect
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceVulnDB for uploading an offline vulnerability database to an air-gapped MSR.
+// The upload is identified by the checksum of the file, a new file replaces the resource.
+func ResourceVulnDB() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVulnDBCreate,
+		ReadContext:   resourceVulnDBRead,
+		UpdateContext: resourceVulnDBUpdate,
+		DeleteContext: resourceVulnDBDelete,
+		CustomizeDiff: customdiff.Sequence(
+			minMSRVersion("mirantis-msr-connect_vuln_db"),
+			resourceVulnDBCustomizeDiff,
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"file_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the vulnerability database tarball.",
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "SHA256 of the uploaded tarball, computed from file_path at plan time when not set.",
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func resourceVulnDBCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// A configured checksum spares hashing the tarball on every plan
+	if config := d.GetRawConfig(); !config.IsNull() && !config.GetAttr("checksum").IsNull() {
+		return nil
+	}
+	if !d.NewValueKnown("file_path") {
+		return nil
+	}
+	sum, err := fileSHA256(d.Get("file_path").(string))
+	// The tarball is often removed once uploaded, which doesn't mean it has to be uploaded again
+	if os.IsNotExist(err) && d.Id() != "" {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if sum != d.Get("checksum").(string) {
+		if err := d.SetNew("checksum", sum); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew("checksum")
+		}
+	}
+	return nil
+}
+
+func resourceVulnDBCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	path := d.Get("file_path").(string)
+	planned := d.Get("checksum").(string)
+	// A tarball which changed since the plan is never uploaded, MSR would import a
+	// database Terraform doesn't track
+	if planned != "" {
+		sum, err := fileSHA256(path)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if sum != planned {
+			return diag.Errorf("%s has the checksum %s instead of %s, it changed since the plan", path, sum, planned)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer f.Close()
+
+	// The checksum is taken from the very bytes which are uploaded
+	h := sha256.New()
+	job, err := c.UploadVulnDB(ctx, filepath.Base(path), io.TeeReader(f, h))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+	// The tarball changed while it was uploaded, so its import is canceled
+	if planned != "" && planned != sum {
+		if err := c.CancelJob(ctx, job.ID); err != nil {
+			return diag.Errorf("the uploaded %s has the checksum %s instead of %s and its import job %s couldn't be canceled: %s", path, sum, planned, job.ID, err)
+		}
+		return diag.Errorf("the uploaded %s has the checksum %s instead of %s, it changed during the upload", path, sum, planned)
+	}
+	// The database is only usable once MSR has imported it
+	if _, err := c.WaitForJob(ctx, job.ID, client.DefaultJobPollInterval); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("checksum", sum); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("job_id", job.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(sum)
+
+	return diag.Diagnostics{}
+}
+
+func resourceVulnDBRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// MSR doesn't expose which tarball the database came from, the state is the only record of it
+	return diag.Diagnostics{}
+}
+
+func resourceVulnDBUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Only file_path can change in place, and a different content forces a new upload
+	return resourceVulnDBRead(ctx, d, m)
+}
+
+func resourceVulnDBDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// An uploaded database can't be removed from MSR, it is only removed from the Terraform state
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
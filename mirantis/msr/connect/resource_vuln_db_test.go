@@ -0,0 +1,122 @@
+package connect_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	connect "github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestVulnDBCustomizeDiff(t *testing.T) {
+	dir := t.TempDir()
+	db := filepath.Join(dir, "cve-db.tar")
+	if err := os.WriteFile(db, []byte("vulnerability database"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte("vulnerability database"))
+	checksum := hex.EncodeToString(sum[:])
+	missing := filepath.Join(dir, "missing.tar")
+
+	testCases := map[string]struct {
+		state             *terraform.InstanceState
+		filePath          string
+		expectErr         bool
+		expectChecksum    string
+		expectReplacement bool
+	}{
+		"new upload": {
+			filePath:       db,
+			expectChecksum: checksum,
+		},
+		"new upload of a missing file": {
+			filePath:  missing,
+			expectErr: true,
+		},
+		"uploaded file removed": {
+			state: &terraform.InstanceState{
+				ID:         checksum,
+				Attributes: map[string]string{"id": checksum, "file_path": missing, "checksum": checksum},
+			},
+			filePath: missing,
+		},
+		"uploaded file changed": {
+			state: &terraform.InstanceState{
+				ID:         "previous",
+				Attributes: map[string]string{"id": "previous", "file_path": db, "checksum": "previous"},
+			},
+			filePath:          db,
+			expectChecksum:    checksum,
+			expectReplacement: true,
+		},
+	}
+	for name, tc := range testCases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"file_path": tc.filePath,
+		})
+		diff, err := connect.ResourceVulnDB().Diff(context.Background(), tc.state, config, client.Client{})
+		if (err != nil) != tc.expectErr {
+			t.Errorf("%s: expected error (%v), got (%v)", name, tc.expectErr, err)
+			continue
+		}
+		var attr *terraform.ResourceAttrDiff
+		if diff != nil {
+			attr = diff.Attributes["checksum"]
+		}
+		if tc.expectChecksum == "" {
+			if attr != nil {
+				t.Errorf("%s: expected no checksum change, got (%+v)", name, attr)
+			}
+			continue
+		}
+		if attr == nil || attr.New != tc.expectChecksum {
+			t.Errorf("%s: expected checksum (%s), got (%+v)", name, tc.expectChecksum, attr)
+			continue
+		}
+		// Every ForceNew attribute of a new resource is marked as requiring a new one
+		if tc.state != nil && attr.RequiresNew != tc.expectReplacement {
+			t.Errorf("%s: expected replacement (%v), got (%v)", name, tc.expectReplacement, attr.RequiresNew)
+		}
+	}
+}
+
+func TestVulnDBCreateChangedFile(t *testing.T) {
+	db := filepath.Join(t.TempDir(), "cve-db.tar")
+	if err := os.WriteFile(db, []byte("changed vulnerability database"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	c, err := client.NewDefaultClient(server.URL, "fakeuser", "fakepass")
+	if err != nil {
+		t.Fatal("couldn't create test client")
+	}
+
+	r := connect.ResourceVulnDB()
+	sum := sha256.Sum256([]byte("vulnerability database"))
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"file_path": db,
+		"checksum":  hex.EncodeToString(sum[:]),
+	})
+	if diags := r.CreateContext(context.Background(), d, c); !diags.HasError() {
+		t.Error("expected the changed file to fail the create")
+	}
+	if requests != 0 {
+		t.Errorf("expected no upload, got (%d) requests", requests)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no ID, got (%s)", d.Id())
+	}
+}
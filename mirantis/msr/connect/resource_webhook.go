@@ -0,0 +1,188 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceWebhook for managing MSR repository and namespace webhooks
+func ResourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		UpdateContext: resourceWebhookUpdate,
+		DeleteContext: resourceWebhookDelete,
+		CustomizeDiff: minMSRVersion("mirantis-msr-connect_webhook"),
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(client.WebhookTypes, false),
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Namespace or 'namespace/repo' the webhook listens to.",
+			},
+			"endpoint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"authorization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Value of the Authorization header sent to the endpoint.",
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate of the endpoint.",
+			},
+			"client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_key"},
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_cert"},
+			},
+			"skip_tls_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	hook := client.Webhook{
+		Type:                d.Get("type").(string),
+		Key:                 d.Get("key").(string),
+		Endpoint:            d.Get("endpoint").(string),
+		Authorization:       d.Get("authorization").(string),
+		TLSCert:             d.Get("ca_cert").(string),
+		ClientCert:          d.Get("client_cert").(string),
+		ClientKey:           d.Get("client_key").(string),
+		SkipTLSVerification: d.Get("skip_tls_verification").(bool),
+		Inactive:            !d.Get("enabled").(bool),
+	}
+	h, err := c.CreateWebhook(ctx, hook)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(h.ID)
+
+	return resourceWebhookRead(ctx, d, m)
+}
+
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	h, err := c.ReadWebhook(ctx, d.Id())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{}
+		}
+		return diag.FromErr(err)
+	}
+
+	// authorization and client_key are write only in MSR, so they are kept as configured
+	values := map[string]interface{}{
+		"type":                  h.Type,
+		"key":                   h.Key,
+		"endpoint":              h.Endpoint,
+		"ca_cert":               h.TLSCert,
+		"client_cert":           h.ClientCert,
+		"skip_tls_verification": h.SkipTLSVerification,
+		"enabled":               !h.Inactive,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diag.Diagnostics{}
+}
+
+func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+
+	if d.HasChangesExcept("last_updated") {
+		hook := client.UpdateWebhook{
+			Endpoint:            d.Get("endpoint").(string),
+			Authorization:       d.Get("authorization").(string),
+			TLSCert:             d.Get("ca_cert").(string),
+			ClientCert:          d.Get("client_cert").(string),
+			ClientKey:           d.Get("client_key").(string),
+			SkipTLSVerification: d.Get("skip_tls_verification").(bool),
+			Inactive:            !d.Get("enabled").(bool),
+		}
+		if _, err := c.UpdateWebhook(ctx, d.Id(), hook); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceWebhookRead(ctx, d, m)
+}
+
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c, ok := m.(client.Client)
+	if !ok {
+		return diag.Errorf("unable to cast meta interface to MSR Client")
+	}
+	if err := c.DeleteWebhook(ctx, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,22 @@
+package connect
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// block returns the attributes of a MaxItems 1 block, or nil when it isn't set
+func block(get func(string) interface{}, key string) map[string]interface{} {
+	l, ok := get(key).([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	return l[0].(map[string]interface{})
+}
+
+// isConfigured tells whether a top level attribute is set in the configuration,
+// unlike GetOk it reports zero values such as false as set
+func isConfigured(d *schema.ResourceData, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return false
+	}
+	return !config.GetAttr(key).IsNull()
+}
@@ -0,0 +1,43 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// checkMSRVersion fails when the MSR version the provider was configured with
+// is older than client.MinSupportedVersion
+func checkMSRVersion(c client.Client, feature string) error {
+	if c.Supports(client.MinSupportedVersion) {
+		return nil
+	}
+	return fmt.Errorf("%s requires MSR %s or newer. %w: MSR version is %s", feature, client.MinSupportedVersion, client.ErrUnsupported, c.Version)
+}
+
+// requireMSRVersion is checkMSRVersion for the CRUD functions, returning a diagnostic
+func requireMSRVersion(c client.Client, feature string) diag.Diagnostics {
+	if err := checkMSRVersion(c, feature); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s requires MSR %s or newer", feature, client.MinSupportedVersion),
+			Detail:   err.Error(),
+		}}
+	}
+	return nil
+}
+
+// minMSRVersion declares a resource as requiring a supported MSR version, failing
+// its plan instead of letting MSR reject the requests of an unsupported feature
+func minMSRVersion(feature string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		c, ok := m.(client.Client)
+		if !ok {
+			return fmt.Errorf("unable to cast meta interface to MSR Client")
+		}
+		return checkMSRVersion(c, feature)
+	}
+}
@@ -0,0 +1,82 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Mirantis/terraform-provider-mirantis/mirantis/msr/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCheckMSRVersion(t *testing.T) {
+	testCases := map[string]struct {
+		version   client.Version
+		expectErr bool
+	}{
+		"unknown": {version: client.Version{}},
+		"older":   {version: client.Version{Major: 2, Minor: 7, Patch: 9}, expectErr: true},
+		"equal":   {version: client.Version{Major: 2, Minor: 8}},
+		"newer":   {version: client.Version{Major: 3, Patch: 1}},
+	}
+	for name, tc := range testCases {
+		err := checkMSRVersion(client.Client{Version: tc.version}, "feature")
+		if tc.expectErr && !errors.Is(err, client.ErrUnsupported) {
+			t.Errorf("%s: expected (%v), got (%v)", name, client.ErrUnsupported, err)
+		}
+		if !tc.expectErr && err != nil {
+			t.Errorf("%s: expected (%v), got (%v)", name, nil, err)
+		}
+	}
+}
+
+// TestMinMSRVersionGated makes sure the resources and data sources with a minimum
+// version fail on an older MSR before they send any request
+func TestMinMSRVersionGated(t *testing.T) {
+	c := client.Client{Version: client.Version{Major: 2, Minor: 7}}
+	p := Provider()
+
+	resources := []string{
+		"mirantis-msr-connect_promotion_policy",
+		"mirantis-msr-connect_push_mirroring_policy",
+		"mirantis-msr-connect_poll_mirroring_policy",
+		"mirantis-msr-connect_webhook",
+		"mirantis-msr-connect_pruning_policy",
+		"mirantis-msr-connect_settings",
+		"mirantis-msr-connect_storage",
+		"mirantis-msr-connect_cron",
+		"mirantis-msr-connect_vuln_db",
+		"mirantis-msr-connect_access_token",
+		"mirantis-msr-connect_ldap",
+		"mirantis-msr-connect_org_member",
+		"mirantis-msr-connect_team_member",
+	}
+	for _, name := range resources {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{})
+		_, err := p.ResourcesMap[name].Diff(context.Background(), nil, config, c)
+		if !errors.Is(err, client.ErrUnsupported) {
+			t.Errorf("%s: expected (%v), got (%v)", name, client.ErrUnsupported, err)
+		}
+	}
+
+	dataSources := []string{
+		"mirantis-msr-connect_repo_tags",
+		"mirantis-msr-connect_webhook_check",
+		"mirantis-msr-connect_scan_summary",
+		"mirantis-msr-connect_jobs",
+		"mirantis-msr-connect_repos",
+		"mirantis-msr-connect_teams",
+		"mirantis-msr-connect_team_members",
+		"mirantis-msr-connect_cluster_status",
+	}
+	for _, name := range dataSources {
+		r := p.DataSourcesMap[name]
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+		diags := r.ReadContext(context.Background(), d, c)
+		if !diags.HasError() || !strings.Contains(diags[0].Summary, "requires MSR") {
+			t.Errorf("%s: expected a minimum version error, got (%v)", name, diags)
+		}
+	}
+}